@@ -0,0 +1,13 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/Novato-Now/novato-fsm/model"
+)
+
+//go:generate mockgen -destination=../mocks/mock_audit_sink.go -package=mocks -source=audit_sink.go
+
+type AuditSink interface {
+	Record(ctx context.Context, entry model.AuditEntry) error
+}
@@ -0,0 +1,25 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Novato-Now/novato-fsm/model"
+	"github.com/Novato-Now/novato-utils/logging"
+)
+
+type loggingAuditSink struct{}
+
+func NewLoggingAuditSink() AuditSink {
+	return loggingAuditSink{}
+}
+
+func (s loggingAuditSink) Record(ctx context.Context, entry model.AuditEntry) error {
+	log := logging.GetLogger(ctx)
+	payload, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	log.Infof("Audit: %s", payload)
+	return nil
+}
@@ -0,0 +1,15 @@
+// Command fsmlint reports structural problems in a flow definition.
+//
+// Declarative flows are linted with -file. Go flows are linted with -flow once their registration
+// package, which calls lint.Register from init, is blank-imported into this command.
+package main
+
+import (
+	"os"
+
+	"github.com/Novato-Now/novato-fsm/lint"
+)
+
+func main() {
+	os.Exit(lint.Main(os.Args[1:], os.Stdout, os.Stderr))
+}
@@ -5,4 +5,5 @@ const (
 	EventNameResume             = "Resume"
 	EventNameBack               = "Back"
 	EventNameTransitionComplete = "TransitionComplete"
+	EventNamePending            = "Pending"
 )
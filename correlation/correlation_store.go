@@ -0,0 +1,20 @@
+package correlation
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -destination=../mocks/mock_correlation_store.go -package=mocks -source=correlation_store.go
+
+// CorrelationStore maps keys chosen by external systems to journeys and remembers which deliveries were processed.
+type CorrelationStore interface {
+	Register(ctx context.Context, correlationKey string, jID string) error
+	Lookup(ctx context.Context, correlationKey string) (jID string, err error)
+	Remove(ctx context.Context, correlationKey string) error
+	// RemoveJourney removes every correlation key registered to the journey.
+	RemoveJourney(ctx context.Context, jID string) error
+	// ClaimDelivery atomically records a delivery and reports false when it was already claimed and has not expired.
+	ClaimDelivery(ctx context.Context, correlationKey string, deliveryID string, expiresAt time.Time) (claimed bool, err error)
+	ReleaseDelivery(ctx context.Context, correlationKey string, deliveryID string) error
+}
@@ -0,0 +1,104 @@
+package correlation
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+var timeNow = time.Now
+
+// sweepInterval is how often claims remove the expired deliveries that are never delivered again.
+const sweepInterval = time.Minute
+
+type deliveryKey struct {
+	correlationKey string
+	deliveryID     string
+}
+
+type inMemoryCorrelationStore struct {
+	journeys    map[string]string
+	deliveries  map[deliveryKey]time.Time
+	nextSweepAt time.Time
+	mu          sync.Mutex
+}
+
+func NewInMemoryCorrelationStore() CorrelationStore {
+	return &inMemoryCorrelationStore{
+		journeys:   make(map[string]string),
+		deliveries: make(map[deliveryKey]time.Time),
+	}
+}
+
+func (s *inMemoryCorrelationStore) Register(ctx context.Context, correlationKey string, jID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if registeredJID, ok := s.journeys[correlationKey]; ok && registeredJID != jID {
+		return fmt.Errorf("correlation key %s is already registered to journey %s", correlationKey, registeredJID)
+	}
+	s.journeys[correlationKey] = jID
+	return nil
+}
+
+func (s *inMemoryCorrelationStore) Lookup(ctx context.Context, correlationKey string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.journeys[correlationKey], nil
+}
+
+func (s *inMemoryCorrelationStore) Remove(ctx context.Context, correlationKey string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.journeys, correlationKey)
+	return nil
+}
+
+func (s *inMemoryCorrelationStore) RemoveJourney(ctx context.Context, jID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for correlationKey, registeredJID := range s.journeys {
+		if registeredJID == jID {
+			delete(s.journeys, correlationKey)
+		}
+	}
+	return nil
+}
+
+func (s *inMemoryCorrelationStore) ClaimDelivery(ctx context.Context, correlationKey string, deliveryID string, expiresAt time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep()
+	key := deliveryKey{correlationKey: correlationKey, deliveryID: deliveryID}
+	if claimedUntil, ok := s.deliveries[key]; ok && claimedUntil.After(timeNow()) {
+		return false, nil
+	}
+	s.deliveries[key] = expiresAt
+	return true, nil
+}
+
+func (s *inMemoryCorrelationStore) ReleaseDelivery(ctx context.Context, correlationKey string, deliveryID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.deliveries, deliveryKey{correlationKey: correlationKey, deliveryID: deliveryID})
+	return nil
+}
+
+func (s *inMemoryCorrelationStore) sweep() {
+	now := timeNow()
+	if now.Before(s.nextSweepAt) {
+		return
+	}
+	for key, claimedUntil := range s.deliveries {
+		if !claimedUntil.After(now) {
+			delete(s.deliveries, key)
+		}
+	}
+	s.nextSweepAt = now.Add(sweepInterval)
+}
@@ -0,0 +1,144 @@
+package correlation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type inMemoryCorrelationStoreTestSuite struct {
+	suite.Suite
+	store CorrelationStore
+	now   time.Time
+	ctx   context.Context
+}
+
+func TestInMemoryCorrelationStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(inMemoryCorrelationStoreTestSuite))
+}
+
+func (suite *inMemoryCorrelationStoreTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time {
+		return suite.now
+	}
+	suite.T().Cleanup(func() { timeNow = time.Now })
+	suite.store = NewInMemoryCorrelationStore()
+}
+
+func (suite *inMemoryCorrelationStoreTestSuite) TestLookup_ShouldReturnJID_WhenKeyIsRegistered() {
+	err := suite.store.Register(suite.ctx, "order-1", "some-uuid")
+	suite.Nil(err)
+
+	jID, err := suite.store.Lookup(suite.ctx, "order-1")
+
+	suite.Equal("some-uuid", jID)
+	suite.Nil(err)
+}
+
+func (suite *inMemoryCorrelationStoreTestSuite) TestLookup_ShouldReturnEmptyJID_WhenKeyIsNotRegistered() {
+	jID, err := suite.store.Lookup(suite.ctx, "order-1")
+
+	suite.Empty(jID)
+	suite.Nil(err)
+}
+
+func (suite *inMemoryCorrelationStoreTestSuite) TestRegister_ShouldReturnError_WhenKeyBelongsToAnotherJourney() {
+	err := suite.store.Register(suite.ctx, "order-1", "some-uuid")
+	suite.Nil(err)
+
+	err = suite.store.Register(suite.ctx, "order-1", "other-uuid")
+
+	suite.EqualError(err, "correlation key order-1 is already registered to journey some-uuid")
+}
+
+func (suite *inMemoryCorrelationStoreTestSuite) TestRegister_ShouldAllowRepeatedRegistrationForSameJourney() {
+	err := suite.store.Register(suite.ctx, "order-1", "some-uuid")
+	suite.Nil(err)
+
+	err = suite.store.Register(suite.ctx, "order-1", "some-uuid")
+
+	suite.Nil(err)
+}
+
+func (suite *inMemoryCorrelationStoreTestSuite) TestRemove_ShouldForgetKey() {
+	err := suite.store.Register(suite.ctx, "order-1", "some-uuid")
+	suite.Nil(err)
+
+	err = suite.store.Remove(suite.ctx, "order-1")
+	suite.Nil(err)
+
+	jID, err := suite.store.Lookup(suite.ctx, "order-1")
+	suite.Empty(jID)
+	suite.Nil(err)
+}
+
+func (suite *inMemoryCorrelationStoreTestSuite) TestClaimDelivery_ShouldReturnFalse_WhenDeliveryIsAlreadyClaimed() {
+	claimed, err := suite.store.ClaimDelivery(suite.ctx, "order-1", "delivery-1", suite.now.Add(time.Minute))
+	suite.True(claimed)
+	suite.Nil(err)
+
+	claimed, err = suite.store.ClaimDelivery(suite.ctx, "order-1", "delivery-1", suite.now.Add(time.Minute))
+
+	suite.False(claimed)
+	suite.Nil(err)
+}
+
+func (suite *inMemoryCorrelationStoreTestSuite) TestClaimDelivery_ShouldReturnTrue_WhenClaimHasExpired() {
+	claimed, err := suite.store.ClaimDelivery(suite.ctx, "order-1", "delivery-1", suite.now)
+	suite.True(claimed)
+	suite.Nil(err)
+
+	claimed, err = suite.store.ClaimDelivery(suite.ctx, "order-1", "delivery-1", suite.now.Add(time.Minute))
+
+	suite.True(claimed)
+	suite.Nil(err)
+}
+
+func (suite *inMemoryCorrelationStoreTestSuite) TestClaimDelivery_ShouldScopeDeliveryIDByCorrelationKey() {
+	claimed, err := suite.store.ClaimDelivery(suite.ctx, "order-1", "delivery-1", suite.now.Add(time.Minute))
+	suite.True(claimed)
+	suite.Nil(err)
+
+	claimed, err = suite.store.ClaimDelivery(suite.ctx, "order-2", "delivery-1", suite.now.Add(time.Minute))
+
+	suite.True(claimed)
+	suite.Nil(err)
+}
+
+func (suite *inMemoryCorrelationStoreTestSuite) TestReleaseDelivery_ShouldAllowDeliveryToBeClaimedAgain() {
+	_, err := suite.store.ClaimDelivery(suite.ctx, "order-1", "delivery-1", suite.now.Add(time.Minute))
+	suite.Nil(err)
+
+	err = suite.store.ReleaseDelivery(suite.ctx, "order-1", "delivery-1")
+	suite.Nil(err)
+
+	claimed, err := suite.store.ClaimDelivery(suite.ctx, "order-1", "delivery-1", suite.now.Add(time.Minute))
+	suite.True(claimed)
+	suite.Nil(err)
+}
+
+func (suite *inMemoryCorrelationStoreTestSuite) TestRemoveJourney_ShouldForgetOnlyKeysOfJourney() {
+	suite.Nil(suite.store.Register(suite.ctx, "order-1", "some-uuid"))
+	suite.Nil(suite.store.Register(suite.ctx, "order-2", "some-uuid"))
+	suite.Nil(suite.store.Register(suite.ctx, "order-3", "other-uuid"))
+
+	err := suite.store.RemoveJourney(suite.ctx, "some-uuid")
+
+	suite.Nil(err)
+	suite.Equal(map[string]string{"order-3": "other-uuid"}, suite.store.(*inMemoryCorrelationStore).journeys)
+}
+
+func (suite *inMemoryCorrelationStoreTestSuite) TestClaimDelivery_ShouldEvictExpiredDeliveriesOfOtherKeys() {
+	_, err := suite.store.ClaimDelivery(suite.ctx, "order-1", "delivery-1", suite.now.Add(time.Minute))
+	suite.Nil(err)
+	suite.now = suite.now.Add(sweepInterval)
+
+	_, err = suite.store.ClaimDelivery(suite.ctx, "order-2", "delivery-2", suite.now.Add(time.Minute))
+
+	suite.Nil(err)
+	suite.Len(suite.store.(*inMemoryCorrelationStore).deliveries, 1)
+}
@@ -1,11 +1,181 @@
 package errors
 
 import (
+	"fmt"
 	"net/http"
+	"runtime"
+	"strings"
+	"sync"
+	"unsafe"
 
 	novato_errors "github.com/Novato-Now/novato-utils/errors"
 )
 
+const (
+	CodeBypass               = "FSM_BYPASS_ERROR"
+	CodeIdempotencyKeyReused = "FSM_IDEMPOTENCY_KEY_REUSED"
+	CodeValidation           = "FSM_VALIDATION_ERROR"
+	CodeJourneyNotFound      = "FSM_JOURNEY_NOT_FOUND"
+	CodeInvalidEvent         = "FSM_INVALID_EVENT"
+	CodeInvalidStartEvent    = "FSM_INVALID_START_EVENT"
+	CodeUnknownState         = "FSM_UNKNOWN_STATE"
+	CodeHandlerFailed        = "FSM_HANDLER_FAILED"
+	CodeStoreUnavailable     = "FSM_STORE_UNAVAILABLE"
+	CodeConflict             = "FSM_CONFLICT"
+	CodeTimeout              = "FSM_TIMEOUT"
+	CodeUnknownCorrelation   = "FSM_UNKNOWN_CORRELATION_KEY"
+	CodeCancelled            = "FSM_CANCELLED"
+	CodeUnknownFlowVersion   = "FSM_UNKNOWN_FLOW_VERSION"
+	CodeJourneyData          = "FSM_JOURNEY_DATA_ERROR"
+	CodeConfiguration        = "FSM_CONFIGURATION_ERROR"
+)
+
+const codePrefix = "FSM_"
+
+// Details are the values an FSM error was built from. Fields that do not apply to the error are empty.
+type Details struct {
+	StateName      string
+	Event          string
+	JID            string
+	CorrelationKey string
+	FlowVersion    string
+	ExpectedType   string
+	ActualType     string
+	Option         string
+	Reason         string
+	Cause          *novato_errors.Error
+}
+
+// errorDetails holds the Details of the errors built by this package. novato_errors.Error has no field for them, so
+// they are kept beside the error, keyed by its address, and removed once the error is garbage collected.
+var errorDetails sync.Map
+
 func BypassError() *novato_errors.Error {
-	return novato_errors.New("FSM_BYPASS_ERROR", http.StatusForbidden)
+	return novato_errors.New(CodeBypass, http.StatusForbidden)
+}
+
+func IdempotencyKeyReusedError() *novato_errors.Error {
+	return novato_errors.New(CodeIdempotencyKeyReused, http.StatusConflict)
+}
+
+func ValidationError() *novato_errors.Error {
+	return novato_errors.New(CodeValidation, http.StatusBadRequest)
+}
+
+func JourneyNotFoundError(jID string) *novato_errors.Error {
+	return withDetails(novato_errors.New(CodeJourneyNotFound, http.StatusNotFound).
+		WithMessage(fmt.Sprintf("journey %s not found", jID)), Details{JID: jID})
+}
+
+func InvalidEventError(stateName string, event string) *novato_errors.Error {
+	return withDetails(novato_errors.New(CodeInvalidEvent, http.StatusUnprocessableEntity).
+		WithMessage(fmt.Sprintf("event %s is not allowed in state %s", event, stateName)), Details{StateName: stateName, Event: event})
+}
+
+func InvalidStartEventError(event string) *novato_errors.Error {
+	return withDetails(novato_errors.New(CodeInvalidStartEvent, http.StatusBadRequest).
+		WithMessage(fmt.Sprintf("event %s cannot start a journey", event)), Details{Event: event})
+}
+
+func UnknownStateError(stateName string) *novato_errors.Error {
+	return withDetails(novato_errors.New(CodeUnknownState, http.StatusInternalServerError).
+		WithMessage(fmt.Sprintf("state %s is not defined", stateName)), Details{StateName: stateName})
+}
+
+// HandlerFailedError reports that the handler of a state failed with cause. The HTTP status of the cause is kept, so a
+// client error raised by a handler stays a client error.
+func HandlerFailedError(stateName string, cause *novato_errors.Error) *novato_errors.Error {
+	httpStatusCode := http.StatusInternalServerError
+	message := fmt.Sprintf("handler of state %s failed", stateName)
+	if cause != nil {
+		if cause.HttpStatusCode != 0 {
+			httpStatusCode = cause.HttpStatusCode
+		}
+		message = fmt.Sprintf("%s: %s", message, cause.Error())
+	}
+	return withDetails(novato_errors.New(CodeHandlerFailed, httpStatusCode).WithMessage(message), Details{StateName: stateName, Cause: cause})
+}
+
+func StoreUnavailableError() *novato_errors.Error {
+	return novato_errors.New(CodeStoreUnavailable, http.StatusServiceUnavailable)
+}
+
+func ConflictError(reason string) *novato_errors.Error {
+	return withDetails(novato_errors.New(CodeConflict, http.StatusConflict).WithMessage(reason), Details{Reason: reason})
+}
+
+// HasCode reports whether err carries the given error code.
+func HasCode(err *novato_errors.Error, code string) bool {
+	return err != nil && err.Code == code
+}
+
+// IsFsmError reports whether err carries one of the codes defined by this package.
+func IsFsmError(err *novato_errors.Error) bool {
+	return err != nil && strings.HasPrefix(err.Code, codePrefix)
+}
+
+// DetailsOf returns the details err was built with. It reports false for errors that were not built by a constructor
+// of this package that takes details.
+func DetailsOf(err *novato_errors.Error) (Details, bool) {
+	if err == nil {
+		return Details{}, false
+	}
+	details, ok := errorDetails.Load(errorKey(err))
+	if !ok {
+		return Details{}, false
+	}
+	return details.(Details), true
+}
+
+func TimeoutError(stateName string) *novato_errors.Error {
+	return withDetails(novato_errors.New(CodeTimeout, http.StatusGatewayTimeout).
+		WithMessage(fmt.Sprintf("handler of state %s timed out", stateName)), Details{StateName: stateName})
+}
+
+func UnknownCorrelationKeyError(correlationKey string) *novato_errors.Error {
+	return withDetails(novato_errors.New(CodeUnknownCorrelation, http.StatusNotFound).
+		WithMessage(fmt.Sprintf("no journey is registered for correlation key %s", correlationKey)), Details{CorrelationKey: correlationKey})
+}
+
+func CancelledError(stateName string) *novato_errors.Error {
+	return withDetails(novato_errors.New(CodeCancelled, http.StatusRequestTimeout).
+		WithMessage(fmt.Sprintf("request was cancelled while waiting for handler of state %s", stateName)), Details{StateName: stateName})
+}
+
+func JourneyDataTypeError(expectedType string, actualType string) *novato_errors.Error {
+	return withDetails(novato_errors.New(CodeJourneyData, http.StatusInternalServerError).
+		WithMessage(fmt.Sprintf("expected journey data of type %s, got %s", expectedType, actualType)), Details{ExpectedType: expectedType, ActualType: actualType})
+}
+
+func JourneyDataError(reason string) *novato_errors.Error {
+	return withDetails(novato_errors.New(CodeJourneyData, http.StatusInternalServerError).WithMessage(reason), Details{Reason: reason})
+}
+
+func UnknownFlowVersionError(jID string, flowVersion string) *novato_errors.Error {
+	return withDetails(novato_errors.New(CodeUnknownFlowVersion, http.StatusInternalServerError).
+		WithMessage(fmt.Sprintf("flow version %s of journey %s is not live", flowVersion, jID)), Details{JID: jID, FlowVersion: flowVersion})
+}
+
+// NotConfiguredError reports a call that needs an option the service was built without.
+func NotConfiguredError(option string) *novato_errors.Error {
+	return withDetails(novato_errors.New(CodeConfiguration, http.StatusInternalServerError).
+		WithMessage(fmt.Sprintf("%s is not configured", option)), Details{Option: option})
+}
+
+// InvalidConfigurationError reports a flow or service configuration that cannot be used.
+func InvalidConfigurationError(reason string) *novato_errors.Error {
+	return withDetails(novato_errors.New(CodeConfiguration, http.StatusInternalServerError).WithMessage(reason), Details{Reason: reason})
+}
+
+func withDetails(err *novato_errors.Error, details Details) *novato_errors.Error {
+	errorDetails.Store(errorKey(err), details)
+	runtime.SetFinalizer(err, func(err *novato_errors.Error) {
+		errorDetails.Delete(errorKey(err))
+	})
+	return err
+}
+
+// errorKey identifies an error without keeping it reachable, so that its finalizer can run.
+func errorKey(err *novato_errors.Error) uintptr {
+	return uintptr(unsafe.Pointer(err))
 }
@@ -0,0 +1,82 @@
+package errors
+
+import (
+	"net/http"
+	"testing"
+
+	novato_errors "github.com/Novato-Now/novato-utils/errors"
+	"github.com/stretchr/testify/suite"
+)
+
+type fsmErrorTestSuite struct {
+	suite.Suite
+}
+
+func TestFsmErrorTestSuite(t *testing.T) {
+	suite.Run(t, new(fsmErrorTestSuite))
+}
+
+func (suite *fsmErrorTestSuite) TestInvalidEventError_ShouldFormatMessage() {
+	err := InvalidEventError("Payment", "Submit")
+
+	suite.Equal(CodeInvalidEvent, err.Code)
+	suite.Equal("event Submit is not allowed in state Payment", err.Message)
+}
+
+func (suite *fsmErrorTestSuite) TestDetailsOf_ShouldReturnDetailsErrorWasBuiltWith() {
+	cause := novato_errors.New("PAYMENT_DECLINED", http.StatusPaymentRequired)
+	testCases := []struct {
+		err             *novato_errors.Error
+		expectedDetails Details
+	}{
+		{err: JourneyNotFoundError("some-uuid"), expectedDetails: Details{JID: "some-uuid"}},
+		{err: InvalidEventError("Review", "Skip is not allowed in state Draft"), expectedDetails: Details{StateName: "Review", Event: "Skip is not allowed in state Draft"}},
+		{err: InvalidStartEventError("Submit"), expectedDetails: Details{Event: "Submit"}},
+		{err: UnknownStateError(""), expectedDetails: Details{}},
+		{err: HandlerFailedError("Payment", cause), expectedDetails: Details{StateName: "Payment", Cause: cause}},
+		{err: TimeoutError("Payment"), expectedDetails: Details{StateName: "Payment"}},
+		{err: CancelledError("Payment"), expectedDetails: Details{StateName: "Payment"}},
+		{err: UnknownCorrelationKeyError("bank-ref-1"), expectedDetails: Details{CorrelationKey: "bank-ref-1"}},
+		{err: UnknownFlowVersionError("some-uuid", "v0"), expectedDetails: Details{JID: "some-uuid", FlowVersion: "v0"}},
+		{err: JourneyDataTypeError("string", "int"), expectedDetails: Details{ExpectedType: "string", ActualType: "int"}},
+		{err: JourneyDataError("expected journey data of type a, got b"), expectedDetails: Details{Reason: "expected journey data of type a, got b"}},
+		{err: NotConfiguredError("dispatcher"), expectedDetails: Details{Option: "dispatcher"}},
+		{err: InvalidConfigurationError("error transitions form a cycle"), expectedDetails: Details{Reason: "error transitions form a cycle"}},
+		{err: ConflictError("journey some-uuid is already completed"), expectedDetails: Details{Reason: "journey some-uuid is already completed"}},
+	}
+
+	for _, testCase := range testCases {
+		details, ok := DetailsOf(testCase.err)
+
+		suite.True(ok, testCase.err.Code)
+		suite.Equal(testCase.expectedDetails, details, testCase.err.Code)
+	}
+}
+
+func (suite *fsmErrorTestSuite) TestDetailsOf_ShouldKeepDetails_WhenMessageIsReplaced() {
+	err := TimeoutError("Payment").WithMessage("payment provider is slow")
+
+	details, ok := DetailsOf(err)
+
+	suite.True(ok)
+	suite.Equal(Details{StateName: "Payment"}, details)
+}
+
+func (suite *fsmErrorTestSuite) TestDetailsOf_ShouldReportNoDetails_WhenErrorWasNotBuiltWithDetails() {
+	for _, err := range []*novato_errors.Error{nil, ValidationError(), novato_errors.New("PAYMENT_DECLINED", http.StatusPaymentRequired)} {
+		details, ok := DetailsOf(err)
+
+		suite.False(ok)
+		suite.Equal(Details{}, details)
+	}
+}
+
+func (suite *fsmErrorTestSuite) TestHandlerFailedError_ShouldKeepStatusOfCause() {
+	cause := novato_errors.New("PAYMENT_DECLINED", http.StatusPaymentRequired).WithMessage("card declined")
+
+	err := HandlerFailedError("Payment", cause)
+
+	suite.Equal(CodeHandlerFailed, err.Code)
+	suite.Equal(http.StatusPaymentRequired, err.HttpStatusCode)
+	suite.Equal("handler of state Payment failed: PAYMENT_DECLINED: card declined", err.Message)
+}
@@ -0,0 +1,124 @@
+package fsmtest
+
+import (
+	"context"
+	"testing"
+
+	fsmConstants "github.com/Novato-Now/novato-fsm/constants"
+	journeystore "github.com/Novato-Now/novato-fsm/journey_store"
+	"github.com/Novato-Now/novato-fsm/model"
+	"github.com/Novato-Now/novato-fsm/service"
+	"github.com/Novato-Now/novato-utils/constants"
+	novato_errors "github.com/Novato-Now/novato-utils/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Driver runs a flow definition against an in-memory journey store and fake handlers.
+// Every step records the latest response and error so assertions can be chained.
+type Driver[T any] struct {
+	t            testing.TB
+	ctx          context.Context
+	journeyStore journeystore.JourneyStore[T]
+	fsmService   service.FsmService[T]
+	jID          string
+	response     model.FsmResponse
+	err          *novato_errors.Error
+}
+
+func NewDriver[T any](
+	t testing.TB,
+	initialState model.FsmState,
+	nonInitStates []model.FsmState,
+	table HandlerTable[T],
+	opts ...service.FsmServiceOption[T],
+) *Driver[T] {
+	t.Helper()
+	journeyStore := NewInMemoryJourneyStore[T]()
+	fakeStates := WithFakeHandlers(table, append([]model.FsmState{initialState}, nonInitStates...)...)
+	fsmService, err := service.NewFsmService(fakeStates[0], fakeStates[1:], journeyStore, model.FsmHooks[T]{}, opts...)
+	require.Nil(t, err)
+
+	return &Driver[T]{
+		t:            t,
+		ctx:          context.WithValue(context.Background(), constants.ServiceNameKey, "FSM"),
+		journeyStore: journeyStore,
+		fsmService:   fsmService,
+	}
+}
+
+func (d *Driver[T]) Start(data any) *Driver[T] {
+	d.execute(model.FsmRequest{Event: fsmConstants.EventNameStart, Data: data})
+	if d.err == nil {
+		d.jID = d.response.JID
+	}
+	return d
+}
+
+func (d *Driver[T]) Send(event string, data any) *Driver[T] {
+	d.execute(model.FsmRequest{JID: d.jID, Event: event, Data: data})
+	return d
+}
+
+func (d *Driver[T]) Back() *Driver[T] {
+	return d.Send(fsmConstants.EventNameBack, nil)
+}
+
+func (d *Driver[T]) Resume() *Driver[T] {
+	return d.Send(fsmConstants.EventNameResume, nil)
+}
+
+func (d *Driver[T]) JID() string {
+	return d.jID
+}
+
+func (d *Driver[T]) Response() model.FsmResponse {
+	return d.response
+}
+
+func (d *Driver[T]) Journey() model.Journey[T] {
+	d.t.Helper()
+	journey, err := d.journeyStore.Get(d.ctx, d.jID)
+	require.Nil(d.t, err)
+	return journey
+}
+
+func (d *Driver[T]) AssertNoError() *Driver[T] {
+	d.t.Helper()
+	assert.Nil(d.t, d.err)
+	return d
+}
+
+func (d *Driver[T]) AssertError(expected *novato_errors.Error) *Driver[T] {
+	d.t.Helper()
+	assert.Equal(d.t, expected, d.err)
+	return d
+}
+
+func (d *Driver[T]) AssertScreen(screen string) *Driver[T] {
+	d.t.Helper()
+	assert.Equal(d.t, screen, d.response.NextScreen)
+	return d
+}
+
+func (d *Driver[T]) AssertResponseData(data any) *Driver[T] {
+	d.t.Helper()
+	assert.Equal(d.t, data, d.response.Data)
+	return d
+}
+
+func (d *Driver[T]) AssertStage(stage string) *Driver[T] {
+	d.t.Helper()
+	assert.Equal(d.t, stage, d.Journey().CurrentStage)
+	return d
+}
+
+func (d *Driver[T]) AssertJourneyData(data T) *Driver[T] {
+	d.t.Helper()
+	assert.Equal(d.t, data, d.Journey().Data)
+	return d
+}
+
+func (d *Driver[T]) execute(request model.FsmRequest) {
+	d.response, d.err = d.fsmService.Execute(d.ctx, request)
+}
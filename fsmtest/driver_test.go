@@ -0,0 +1,107 @@
+package fsmtest
+
+import (
+	"testing"
+
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/model"
+)
+
+type onboardingData struct {
+	Name     string
+	Verified bool
+}
+
+func onboardingFlow() (model.FsmState, []model.FsmState, HandlerTable[onboardingData]) {
+	initState := model.FsmState{
+		Name:                "Welcome",
+		NextScreen:          "WelcomeScreen",
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Details"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:       "Details",
+			NextScreen: "DetailsScreen",
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "Back", DestinationStateName: "Welcome"},
+				{Event: "Submit", DestinationStateName: "Verify"},
+			},
+		},
+		{
+			Name:                "Verify",
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Verified", DestinationStateName: "Done"}},
+		},
+		{
+			Name:           "Done",
+			NextScreen:     "DoneScreen",
+			IsCheckpoint:   true,
+			TerminalStatus: model.JourneyStatusSucceeded,
+		},
+	}
+	table := HandlerTable[onboardingData]{
+		"Welcome": {Response: "welcome", RevisitResponse: "welcome-again"},
+		"Verify": {
+			NextEvent: "Verified",
+			Mutate: func(journeyData onboardingData, data any) onboardingData {
+				journeyData.Name = data.(string)
+				return journeyData
+			},
+		},
+		"Done": {
+			Response: "done",
+			Mutate: func(journeyData onboardingData, data any) onboardingData {
+				journeyData.Verified = true
+				return journeyData
+			},
+		},
+	}
+	return initState, nonInitStates, table
+}
+
+func TestDriver_ShouldRunCompleteFlow(t *testing.T) {
+	initState, nonInitStates, table := onboardingFlow()
+
+	NewDriver(t, initState, nonInitStates, table).
+		Start(nil).
+		AssertNoError().
+		AssertScreen("WelcomeScreen").
+		AssertResponseData("welcome").
+		AssertStage("Welcome").
+		Send("Next", nil).
+		AssertScreen("DetailsScreen").
+		Back().
+		AssertScreen("WelcomeScreen").
+		AssertResponseData("welcome-again").
+		Send("Next", nil).
+		Send("Submit", "Jane").
+		AssertNoError().
+		AssertScreen("DoneScreen").
+		AssertResponseData("done").
+		AssertStage("Done").
+		AssertJourneyData(onboardingData{Name: "Jane", Verified: true})
+}
+
+func TestDriver_ShouldRecordError_WhenEventIsInvalid(t *testing.T) {
+	initState, nonInitStates, table := onboardingFlow()
+
+	NewDriver(t, initState, nonInitStates, table).
+		Start(nil).
+		Send("Submit", nil).
+		AssertError(fsmErrors.InvalidEventError("Welcome", "Submit")).
+		AssertStage("Welcome")
+}
+
+func TestDriver_ShouldReturnHandlerError_WhenBehaviourFails(t *testing.T) {
+	initState, nonInitStates, table := onboardingFlow()
+	table["Details"] = HandlerBehaviour[onboardingData]{Err: fsmErrors.BypassError().WithMessage("details failed")}
+
+	NewDriver(t, initState, nonInitStates, table).
+		Start(nil).
+		Send("Next", nil).
+		AssertError(fsmErrors.BypassError().WithMessage("details failed")).
+		AssertStage("Welcome").
+		Resume().
+		AssertNoError().
+		AssertResponseData("welcome-again")
+}
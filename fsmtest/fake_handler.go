@@ -0,0 +1,63 @@
+package fsmtest
+
+import (
+	"context"
+
+	"github.com/Novato-Now/novato-fsm/constants"
+	"github.com/Novato-Now/novato-fsm/model"
+	"github.com/Novato-Now/novato-fsm/state_handler"
+	novato_errors "github.com/Novato-Now/novato-utils/errors"
+)
+
+// HandlerBehaviour scripts a fake state handler. An empty NextEvent completes the transition.
+type HandlerBehaviour[T any] struct {
+	Response        any
+	RevisitResponse any
+	Mutate          func(journeyData T, data any) T
+	NextEvent       string
+	Err             *novato_errors.Error
+}
+
+// HandlerTable maps state names to the behaviour of their fake handlers.
+type HandlerTable[T any] map[string]HandlerBehaviour[T]
+
+type fakeHandler[T any] struct {
+	behaviour HandlerBehaviour[T]
+}
+
+func NewFakeHandler[T any](behaviour HandlerBehaviour[T]) state_handler.StateHandler {
+	return fakeHandler[T]{behaviour: behaviour}
+}
+
+// WithFakeHandlers returns a copy of states with each handler replaced by a fake scripted from table.
+// States missing from table get a handler that completes the transition without changes.
+func WithFakeHandlers[T any](table HandlerTable[T], states ...model.FsmState) []model.FsmState {
+	fakeStates := make([]model.FsmState, 0, len(states))
+	for _, state := range states {
+		state.StateHandler = NewFakeHandler(table[state.Name])
+		fakeStates = append(fakeStates, state)
+	}
+	return fakeStates
+}
+
+func (h fakeHandler[T]) Visit(ctx context.Context, jID string, journeyData any, data any) (any, any, string, *novato_errors.Error) {
+	if h.behaviour.Err != nil {
+		return nil, nil, "", h.behaviour.Err
+	}
+	updatedJourneyData := journeyData.(T)
+	if h.behaviour.Mutate != nil {
+		updatedJourneyData = h.behaviour.Mutate(updatedJourneyData, data)
+	}
+	nextEvent := h.behaviour.NextEvent
+	if nextEvent == "" {
+		nextEvent = constants.EventNameTransitionComplete
+	}
+	return h.behaviour.Response, updatedJourneyData, nextEvent, nil
+}
+
+func (h fakeHandler[T]) Revisit(ctx context.Context, jID string, journeyData any) (any, any, *novato_errors.Error) {
+	if h.behaviour.Err != nil {
+		return nil, nil, h.behaviour.Err
+	}
+	return h.behaviour.RevisitResponse, journeyData, nil
+}
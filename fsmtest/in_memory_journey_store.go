@@ -0,0 +1,49 @@
+package fsmtest
+
+import (
+	"context"
+	"sync"
+
+	journeystore "github.com/Novato-Now/novato-fsm/journey_store"
+	"github.com/Novato-Now/novato-fsm/model"
+)
+
+type inMemoryKeyValueStore[T any] struct {
+	journeys map[string]model.Journey[T]
+	mu       sync.Mutex
+}
+
+func NewInMemoryKeyValueStore[T any]() journeystore.KeyValueStore[T] {
+	return &inMemoryKeyValueStore[T]{journeys: make(map[string]model.Journey[T])}
+}
+
+func NewInMemoryJourneyStore[T any]() journeystore.JourneyStore[T] {
+	return journeystore.NewJourneyStore(NewInMemoryKeyValueStore[T]())
+}
+
+func (s *inMemoryKeyValueStore[T]) Set(ctx context.Context, key string, value model.Journey[T]) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.journeys[key] = value
+	return nil
+}
+
+func (s *inMemoryKeyValueStore[T]) Get(ctx context.Context, key string) (*model.Journey[T], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	journey, ok := s.journeys[key]
+	if !ok {
+		return nil, nil
+	}
+	return &journey, nil
+}
+
+func (s *inMemoryKeyValueStore[T]) Del(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.journeys, key)
+	return nil
+}
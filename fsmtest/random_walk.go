@@ -0,0 +1,241 @@
+package fsmtest
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	fsmConstants "github.com/Novato-Now/novato-fsm/constants"
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	journeystore "github.com/Novato-Now/novato-fsm/journey_store"
+	"github.com/Novato-Now/novato-fsm/model"
+	"github.com/Novato-Now/novato-fsm/service"
+	"github.com/Novato-Now/novato-utils/constants"
+	novato_errors "github.com/Novato-Now/novato-utils/errors"
+)
+
+// PayloadGenerator returns the request data sent with event while the journey is in stateName.
+type PayloadGenerator func(rnd *rand.Rand, stateName string, event string) any
+
+// Flow is a flow definition exercised by RandomWalk. The states are used as given, so their handlers
+// must be safe to call repeatedly; WithFakeHandlers is a convenient way to build them. GlobalEvents are
+// registered with the service and picked by the walk like the events of the current state.
+type Flow[T any] struct {
+	InitialState  model.FsmState
+	NonInitStates []model.FsmState
+	GlobalEvents  []model.NextAvailableEvent
+	Options       []service.FsmServiceOption[T]
+	Payload       PayloadGenerator
+}
+
+type randomWalk[T any] struct {
+	t            testing.TB
+	ctx          context.Context
+	seed         int64
+	rnd          *rand.Rand
+	flow         Flow[T]
+	states       map[string]model.FsmState
+	journeyStore *checkingJourneyStore[T]
+	fsmService   service.FsmService[T]
+	trace        []string
+}
+
+// RandomWalk sends steps randomly chosen events, Back and Resume requests through Execute and fails t
+// as soon as an invariant is broken. Events are picked from the current state, the global events and the
+// states of the active parallel regions. The walk is fully determined by seed.
+func RandomWalk[T any](t testing.TB, flow Flow[T], seed int64, steps int) {
+	t.Helper()
+	states := make(map[string]model.FsmState, len(flow.NonInitStates)+1)
+	for _, state := range append([]model.FsmState{flow.InitialState}, flow.NonInitStates...) {
+		states[state.Name] = state
+	}
+	journeyStore := &checkingJourneyStore[T]{JourneyStore: NewInMemoryJourneyStore[T](), states: states}
+	opts := append([]service.FsmServiceOption[T]{service.WithGlobalEvents[T](flow.GlobalEvents...)}, flow.Options...)
+	fsmService, err := service.NewFsmService(flow.InitialState, flow.NonInitStates, journeyStore, model.FsmHooks[T]{}, opts...)
+	if err != nil {
+		t.Fatalf("seed %d: unable to create fsm service: %+v", seed, err)
+	}
+
+	walk := randomWalk[T]{
+		t:            t,
+		ctx:          context.WithValue(context.Background(), constants.ServiceNameKey, "FSM"),
+		seed:         seed,
+		rnd:          rand.New(rand.NewSource(seed)),
+		flow:         flow,
+		states:       states,
+		journeyStore: journeyStore,
+		fsmService:   fsmService,
+	}
+	walk.run(steps)
+}
+
+// FuzzRandomWalk registers flow with Go's native fuzzing. Each fuzz input is the seed of one random walk,
+// so failing inputs saved under testdata replay the same walk.
+func FuzzRandomWalk[T any](f *testing.F, flow Flow[T], steps int) {
+	for seed := int64(0); seed < 8; seed++ {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, seed int64) {
+		RandomWalk(t, flow, seed, steps)
+	})
+}
+
+func (w *randomWalk[T]) run(steps int) {
+	w.t.Helper()
+	var jID string
+	for step := 0; step < steps; step++ {
+		var request model.FsmRequest
+		if jID == "" {
+			request = model.FsmRequest{Event: fsmConstants.EventNameStart, Data: w.payload(w.flow.InitialState.Name, fsmConstants.EventNameStart)}
+		} else {
+			request = w.pickRequest(w.journey(jID))
+		}
+		w.trace = append(w.trace, traceEntry(request))
+
+		response, err := w.execute(request)
+		if w.journeyStore.violation != "" {
+			w.fail(w.journeyStore.violation)
+		}
+		if jID == "" {
+			if err != nil {
+				continue
+			}
+			jID = response.JID
+		}
+
+		journey, getErr := w.journeyStore.Get(w.ctx, jID)
+		if getErr != nil {
+			// A journey may only disappear when a step completes it and the service deletes completed journeys.
+			if err == nil && !fsmErrors.HasCode(getErr, fsmErrors.CodeJourneyNotFound) {
+				w.fail(fmt.Sprintf("unable to fetch journey %s: %+v", jID, getErr))
+			}
+			jID = ""
+			continue
+		}
+		if violation := checkJourney(w.states, journey); violation != "" {
+			w.fail(violation)
+		}
+		if journey.IsCompleted() {
+			jID = ""
+		}
+	}
+}
+
+func (w *randomWalk[T]) execute(request model.FsmRequest) (response model.FsmResponse, err *novato_errors.Error) {
+	w.t.Helper()
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			w.fail(fmt.Sprintf("panic: %v", recovered))
+		}
+	}()
+	return w.fsmService.Execute(w.ctx, request)
+}
+
+func (w *randomWalk[T]) journey(jID string) model.Journey[T] {
+	w.t.Helper()
+	journey, err := w.journeyStore.Get(w.ctx, jID)
+	if err != nil {
+		w.fail(fmt.Sprintf("unable to fetch journey %s: %+v", jID, err))
+	}
+	return journey
+}
+
+// pickRequest picks Back, Resume, an event of the current state, a global event the state does not exclude or an
+// event of the current state of an active region.
+func (w *randomWalk[T]) pickRequest(journey model.Journey[T]) model.FsmRequest {
+	candidates := []model.FsmRequest{{Event: fsmConstants.EventNameBack}, {Event: fsmConstants.EventNameResume}}
+	addCandidate := func(region string, event string) {
+		candidate := model.FsmRequest{Event: event, Region: region}
+		if !slices.Contains(candidates, candidate) {
+			candidates = append(candidates, candidate)
+		}
+	}
+	currentState := w.states[journey.CurrentStage]
+	for _, nextAvailableEvent := range currentState.NextAvailableEvents {
+		addCandidate("", nextAvailableEvent.Event)
+	}
+	for _, globalEvent := range w.flow.GlobalEvents {
+		if !slices.Contains(currentState.ExcludedGlobalEvents, globalEvent.Event) {
+			addCandidate("", globalEvent.Event)
+		}
+	}
+	regionNames := make([]string, 0, len(journey.Regions))
+	for regionName := range journey.Regions {
+		regionNames = append(regionNames, regionName)
+	}
+	slices.Sort(regionNames)
+	for _, regionName := range regionNames {
+		region := journey.Regions[regionName]
+		if region.IsComplete {
+			continue
+		}
+		for _, nextAvailableEvent := range w.states[region.CurrentStage].NextAvailableEvents {
+			addCandidate(regionName, nextAvailableEvent.Event)
+		}
+	}
+
+	request := candidates[w.rnd.Intn(len(candidates))]
+	request.JID = journey.JID
+	stateName := journey.CurrentStage
+	if request.Region != "" {
+		stateName = journey.Regions[request.Region].CurrentStage
+	}
+	request.Data = w.payload(stateName, request.Event)
+	return request
+}
+
+func traceEntry(request model.FsmRequest) string {
+	if request.Region == "" {
+		return request.Event
+	}
+	return request.Region + ":" + request.Event
+}
+
+func (w *randomWalk[T]) payload(stateName string, event string) any {
+	if w.flow.Payload == nil {
+		return nil
+	}
+	return w.flow.Payload(w.rnd, stateName, event)
+}
+
+func (w *randomWalk[T]) fail(violation string) {
+	w.t.Helper()
+	w.t.Fatalf("seed %d: step %d: %s\nevents: %s", w.seed, len(w.trace), violation, strings.Join(w.trace, " -> "))
+}
+
+func checkJourney[T any](states map[string]model.FsmState, journey model.Journey[T]) string {
+	currentState, ok := states[journey.CurrentStage]
+	if !ok {
+		return fmt.Sprintf("journey %s is in unknown state %q", journey.JID, journey.CurrentStage)
+	}
+	if journey.LastCheckpointStage != "" && !states[journey.LastCheckpointStage].IsCheckpoint {
+		return fmt.Sprintf("journey %s has last checkpoint %q which is not a checkpoint state", journey.JID, journey.LastCheckpointStage)
+	}
+	for regionName, region := range journey.Regions {
+		if _, ok := states[region.CurrentStage]; !ok {
+			return fmt.Sprintf("journey %s region %s is in unknown state %q", journey.JID, regionName, region.CurrentStage)
+		}
+	}
+	for _, nextAvailableEvent := range currentState.NextAvailableEvents {
+		if _, ok := states[nextAvailableEvent.DestinationStateName]; !ok {
+			return fmt.Sprintf("state %s has event %s to unknown state %q", currentState.Name, nextAvailableEvent.Event, nextAvailableEvent.DestinationStateName)
+		}
+	}
+	return ""
+}
+
+type checkingJourneyStore[T any] struct {
+	journeystore.JourneyStore[T]
+	states    map[string]model.FsmState
+	violation string
+}
+
+func (s *checkingJourneyStore[T]) Save(ctx context.Context, journey model.Journey[T]) *novato_errors.Error {
+	if s.violation == "" {
+		s.violation = checkJourney(s.states, journey)
+	}
+	return s.JourneyStore.Save(ctx, journey)
+}
@@ -0,0 +1,136 @@
+package fsmtest
+
+import (
+	"fmt"
+	"math/rand"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/Novato-Now/novato-fsm/model"
+	"github.com/stretchr/testify/assert"
+)
+
+type fatalRecorder struct {
+	testing.TB
+	message string
+}
+
+func (r *fatalRecorder) Helper() {}
+
+func (r *fatalRecorder) Fatalf(format string, args ...any) {
+	r.message = fmt.Sprintf(format, args...)
+	runtime.Goexit()
+}
+
+func recordRandomWalk[T any](t *testing.T, flow Flow[T], seed int64, steps int) string {
+	recorder := &fatalRecorder{TB: t}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		RandomWalk[T](recorder, flow, seed, steps)
+	}()
+	<-done
+	return recorder.message
+}
+
+func onboardingWalkFlow() Flow[onboardingData] {
+	initState, nonInitStates, table := onboardingFlow()
+	states := WithFakeHandlers(table, append([]model.FsmState{initState}, nonInitStates...)...)
+	return Flow[onboardingData]{
+		InitialState:  states[0],
+		NonInitStates: states[1:],
+		Payload: func(rnd *rand.Rand, stateName string, event string) any {
+			return fmt.Sprintf("name-%d", rnd.Intn(100))
+		},
+	}
+}
+
+func TestRandomWalk_ShouldPass_WhenFlowKeepsInvariants(t *testing.T) {
+	for seed := int64(0); seed < 20; seed++ {
+		assert.Empty(t, recordRandomWalk(t, onboardingWalkFlow(), seed, 50))
+	}
+}
+
+func TestRandomWalk_ShouldFail_WhenEventLeadsToUnknownState(t *testing.T) {
+	flow := onboardingWalkFlow()
+	flow.NonInitStates[0].NextAvailableEvents = append(flow.NonInitStates[0].NextAvailableEvents, model.NextAvailableEvent{Event: "Skip", DestinationStateName: "Missing"})
+
+	message := recordRandomWalk(t, flow, 1, 50)
+
+	assert.True(t, strings.HasPrefix(message, "seed 1: step "), message)
+	assert.Contains(t, message, `state Details has event Skip to unknown state "Missing"`)
+}
+
+func TestRandomWalk_ShouldFail_WhenHandlerPanics(t *testing.T) {
+	flow := onboardingWalkFlow()
+	flow.NonInitStates[1].StateHandler = NewFakeHandler(HandlerBehaviour[onboardingData]{
+		Mutate: func(journeyData onboardingData, data any) onboardingData {
+			panic("verification exploded")
+		},
+	})
+
+	message := recordRandomWalk(t, flow, 3, 200)
+
+	assert.Contains(t, message, "panic: verification exploded")
+}
+
+func FuzzRandomWalk_Onboarding(f *testing.F) {
+	FuzzRandomWalk(f, onboardingWalkFlow(), 50)
+}
+
+func TestRandomWalk_ShouldSendGlobalEvents(t *testing.T) {
+	flow := onboardingWalkFlow()
+	flow.GlobalEvents = []model.NextAvailableEvent{{Event: "Cancel", DestinationStateName: "Cancelled"}}
+	flow.NonInitStates = append(flow.NonInitStates, model.FsmState{
+		Name:           "Cancelled",
+		TerminalStatus: model.JourneyStatusFailed,
+		StateHandler: NewFakeHandler(HandlerBehaviour[onboardingData]{
+			Mutate: func(journeyData onboardingData, data any) onboardingData {
+				panic("cancelled")
+			},
+		}),
+	})
+
+	message := recordRandomWalk(t, flow, 1, 200)
+
+	assert.Contains(t, message, "panic: cancelled")
+	assert.Contains(t, message, "Cancel")
+}
+
+func TestRandomWalk_ShouldSendRegionEvents_WhenRegionsAreActive(t *testing.T) {
+	initState := model.FsmState{
+		Name:                "Welcome",
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Fork"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name: "Fork",
+			ParallelRegions: []model.ParallelRegion{
+				{Name: "documents", InitialStateName: "DocumentsPending", FinalStateNames: []string{"DocumentsUploaded"}},
+			},
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Join"}},
+		},
+		{
+			Name:                "DocumentsPending",
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Upload", DestinationStateName: "DocumentsUploaded"}},
+		},
+		{Name: "DocumentsUploaded"},
+		{Name: "Join", IsJoin: true},
+	}
+	table := HandlerTable[onboardingData]{
+		"DocumentsUploaded": {
+			Mutate: func(journeyData onboardingData, data any) onboardingData {
+				panic("documents uploaded")
+			},
+		},
+	}
+	states := WithFakeHandlers(table, append([]model.FsmState{initState}, nonInitStates...)...)
+	flow := Flow[onboardingData]{InitialState: states[0], NonInitStates: states[1:]}
+
+	message := recordRandomWalk(t, flow, 1, 200)
+
+	assert.Contains(t, message, "panic: documents uploaded")
+	assert.Contains(t, message, "documents:Upload")
+}
@@ -0,0 +1,17 @@
+package idempotency
+
+import (
+	"context"
+
+	"github.com/Novato-Now/novato-fsm/model"
+)
+
+//go:generate mockgen -destination=../mocks/mock_idempotency_store.go -package=mocks -source=idempotency_store.go
+
+type IdempotencyStore interface {
+	Get(ctx context.Context, scope model.IdempotencyScope, key string) (*model.IdempotencyRecord, error)
+	// Claim atomically stores record unless an unexpired record exists for the key, in which case that record is returned instead.
+	Claim(ctx context.Context, scope model.IdempotencyScope, key string, record model.IdempotencyRecord) (*model.IdempotencyRecord, error)
+	Set(ctx context.Context, scope model.IdempotencyScope, key string, record model.IdempotencyRecord) error
+	Delete(ctx context.Context, scope model.IdempotencyScope, key string) error
+}
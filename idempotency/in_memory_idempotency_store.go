@@ -0,0 +1,86 @@
+package idempotency
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Novato-Now/novato-fsm/model"
+)
+
+var timeNow = time.Now
+
+// sweepInterval is how often writes remove the expired records of keys that are never read again.
+const sweepInterval = time.Minute
+
+type recordKey struct {
+	scope model.IdempotencyScope
+	key   string
+}
+
+type inMemoryIdempotencyStore struct {
+	records     map[recordKey]model.IdempotencyRecord
+	nextSweepAt time.Time
+	mu          sync.Mutex
+}
+
+func NewInMemoryIdempotencyStore() IdempotencyStore {
+	return &inMemoryIdempotencyStore{records: make(map[recordKey]model.IdempotencyRecord)}
+}
+
+func (s *inMemoryIdempotencyStore) Get(ctx context.Context, scope model.IdempotencyScope, key string) (*model.IdempotencyRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.get(recordKey{scope: scope, key: key}), nil
+}
+
+func (s *inMemoryIdempotencyStore) Claim(ctx context.Context, scope model.IdempotencyScope, key string, record model.IdempotencyRecord) (*model.IdempotencyRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sweep()
+	if existing := s.get(recordKey{scope: scope, key: key}); existing != nil {
+		return existing, nil
+	}
+	s.records[recordKey{scope: scope, key: key}] = record
+	return nil, nil
+}
+
+func (s *inMemoryIdempotencyStore) Set(ctx context.Context, scope model.IdempotencyScope, key string, record model.IdempotencyRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sweep()
+	s.records[recordKey{scope: scope, key: key}] = record
+	return nil
+}
+
+func (s *inMemoryIdempotencyStore) Delete(ctx context.Context, scope model.IdempotencyScope, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.records, recordKey{scope: scope, key: key})
+	return nil
+}
+
+func (s *inMemoryIdempotencyStore) get(key recordKey) *model.IdempotencyRecord {
+	record, ok := s.records[key]
+	if !ok {
+		return nil
+	}
+	if !record.ExpiresAt.After(timeNow()) {
+		delete(s.records, key)
+		return nil
+	}
+	return &record
+}
+
+func (s *inMemoryIdempotencyStore) sweep() {
+	now := timeNow()
+	if now.Before(s.nextSweepAt) {
+		return
+	}
+	for key, record := range s.records {
+		if !record.ExpiresAt.After(now) {
+			delete(s.records, key)
+		}
+	}
+	s.nextSweepAt = now.Add(sweepInterval)
+}
@@ -0,0 +1,138 @@
+package idempotency
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Novato-Now/novato-fsm/model"
+	"github.com/stretchr/testify/suite"
+)
+
+type inMemoryIdempotencyStoreTestSuite struct {
+	suite.Suite
+	store IdempotencyStore
+	now   time.Time
+	ctx   context.Context
+}
+
+func TestInMemoryIdempotencyStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(inMemoryIdempotencyStoreTestSuite))
+}
+
+func (suite *inMemoryIdempotencyStoreTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time {
+		return suite.now
+	}
+	suite.T().Cleanup(func() { timeNow = time.Now })
+	suite.store = NewInMemoryIdempotencyStore()
+}
+
+func (suite *inMemoryIdempotencyStoreTestSuite) TestGet_ShouldReturnRecord_WhenRecordHasNotExpired() {
+	record := model.IdempotencyRecord{RequestHash: "some-hash", ExpiresAt: suite.now.Add(time.Minute)}
+	err := suite.store.Set(suite.ctx, model.IdempotencyScope{JID: "some-uuid"}, "some-key", record)
+	suite.Nil(err)
+
+	storedRecord, err := suite.store.Get(suite.ctx, model.IdempotencyScope{JID: "some-uuid"}, "some-key")
+
+	suite.Equal(&record, storedRecord)
+	suite.Nil(err)
+}
+
+func (suite *inMemoryIdempotencyStoreTestSuite) TestGet_ShouldReturnNoRecord_WhenRecordHasExpired() {
+	err := suite.store.Set(suite.ctx, model.IdempotencyScope{JID: "some-uuid"}, "some-key", model.IdempotencyRecord{RequestHash: "some-hash", ExpiresAt: suite.now})
+	suite.Nil(err)
+
+	storedRecord, err := suite.store.Get(suite.ctx, model.IdempotencyScope{JID: "some-uuid"}, "some-key")
+
+	suite.Nil(storedRecord)
+	suite.Nil(err)
+}
+
+func (suite *inMemoryIdempotencyStoreTestSuite) TestGet_ShouldReturnNoRecord_WhenKeyBelongsToAnotherJourney() {
+	err := suite.store.Set(suite.ctx, model.IdempotencyScope{JID: "some-uuid"}, "some-key", model.IdempotencyRecord{RequestHash: "some-hash", ExpiresAt: suite.now.Add(time.Minute)})
+	suite.Nil(err)
+
+	storedRecord, err := suite.store.Get(suite.ctx, model.IdempotencyScope{JID: "other-uuid"}, "some-key")
+
+	suite.Nil(storedRecord)
+	suite.Nil(err)
+}
+
+func (suite *inMemoryIdempotencyStoreTestSuite) TestGet_ShouldReturnNoRecord_WhenScopeAndKeyOnlyJoinToTheSameString() {
+	err := suite.store.Set(suite.ctx, model.IdempotencyScope{JID: "some_uuid"}, "key", model.IdempotencyRecord{RequestHash: "some-hash", ExpiresAt: suite.now.Add(time.Minute)})
+	suite.Nil(err)
+
+	storedRecord, err := suite.store.Get(suite.ctx, model.IdempotencyScope{JID: "some"}, "uuid_key")
+
+	suite.Nil(storedRecord)
+	suite.Nil(err)
+}
+
+func (suite *inMemoryIdempotencyStoreTestSuite) TestClaim_ShouldStoreRecord_WhenKeyIsFree() {
+	record := model.IdempotencyRecord{RequestHash: "some-hash", ExpiresAt: suite.now.Add(time.Minute), IsInProgress: true}
+
+	existingRecord, err := suite.store.Claim(suite.ctx, model.IdempotencyScope{CallerID: "some-caller"}, "some-key", record)
+
+	suite.Nil(existingRecord)
+	suite.Nil(err)
+	storedRecord, err := suite.store.Get(suite.ctx, model.IdempotencyScope{CallerID: "some-caller"}, "some-key")
+	suite.Equal(&record, storedRecord)
+	suite.Nil(err)
+}
+
+func (suite *inMemoryIdempotencyStoreTestSuite) TestClaim_ShouldReturnExistingRecord_WhenKeyIsTaken() {
+	record := model.IdempotencyRecord{RequestHash: "some-hash", ExpiresAt: suite.now.Add(time.Minute), IsInProgress: true}
+	_, err := suite.store.Claim(suite.ctx, model.IdempotencyScope{JID: "some-uuid"}, "some-key", record)
+	suite.Nil(err)
+
+	existingRecord, err := suite.store.Claim(suite.ctx, model.IdempotencyScope{JID: "some-uuid"}, "some-key", model.IdempotencyRecord{RequestHash: "other-hash"})
+
+	suite.Equal(&record, existingRecord)
+	suite.Nil(err)
+}
+
+func (suite *inMemoryIdempotencyStoreTestSuite) TestClaim_ShouldGrantKeyToOneCaller_WhenClaimedConcurrently() {
+	var wg sync.WaitGroup
+	var claims atomic.Int32
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			existingRecord, _ := suite.store.Claim(suite.ctx, model.IdempotencyScope{JID: "some-uuid"}, "some-key", model.IdempotencyRecord{ExpiresAt: suite.now.Add(time.Minute)})
+			if existingRecord == nil {
+				claims.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	suite.Equal(int32(1), claims.Load())
+}
+
+func (suite *inMemoryIdempotencyStoreTestSuite) TestDelete_ShouldReleaseClaim() {
+	_, err := suite.store.Claim(suite.ctx, model.IdempotencyScope{JID: "some-uuid"}, "some-key", model.IdempotencyRecord{ExpiresAt: suite.now.Add(time.Minute)})
+	suite.Nil(err)
+
+	err = suite.store.Delete(suite.ctx, model.IdempotencyScope{JID: "some-uuid"}, "some-key")
+
+	suite.Nil(err)
+	storedRecord, err := suite.store.Get(suite.ctx, model.IdempotencyScope{JID: "some-uuid"}, "some-key")
+	suite.Nil(storedRecord)
+	suite.Nil(err)
+}
+
+func (suite *inMemoryIdempotencyStoreTestSuite) TestSet_ShouldEvictExpiredRecordsOfOtherKeys() {
+	err := suite.store.Set(suite.ctx, model.IdempotencyScope{JID: "some-uuid"}, "some-key", model.IdempotencyRecord{ExpiresAt: suite.now.Add(time.Minute)})
+	suite.Nil(err)
+	suite.now = suite.now.Add(sweepInterval)
+
+	err = suite.store.Set(suite.ctx, model.IdempotencyScope{JID: "other-uuid"}, "other-key", model.IdempotencyRecord{ExpiresAt: suite.now.Add(time.Minute)})
+
+	suite.Nil(err)
+	suite.Len(suite.store.(*inMemoryIdempotencyStore).records, 1)
+}
@@ -0,0 +1,14 @@
+package journeystore
+
+import (
+	"context"
+
+	"github.com/Novato-Now/novato-fsm/model"
+	novato_errors "github.com/Novato-Now/novato-utils/errors"
+)
+
+//go:generate mockgen -destination=../mocks/mock_journey_archiver.go -package=mocks -source=journey_archiver.go
+
+type JourneyArchiver[T any] interface {
+	Archive(ctx context.Context, journey model.Journey[T]) *novato_errors.Error
+}
@@ -0,0 +1,16 @@
+package journeystore
+
+import (
+	"context"
+
+	"github.com/Novato-Now/novato-fsm/model"
+	novato_errors "github.com/Novato-Now/novato-utils/errors"
+)
+
+//go:generate mockgen -destination=../mocks/mock_journey_lister.go -package=mocks -source=journey_lister.go
+
+// JourneyLister is implemented by journey stores that can page through every stored journey.
+// An empty nextCursor marks the last page.
+type JourneyLister[T any] interface {
+	List(ctx context.Context, cursor string, limit int) (journeys []model.Journey[T], nextCursor string, err *novato_errors.Error)
+}
@@ -2,6 +2,7 @@ package journeystore
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
 
 	"github.com/Novato-Now/novato-fsm/errors"
@@ -44,7 +45,7 @@ func (js journeyStore[T]) Create(ctx context.Context) (model.Journey[T], *novato
 	err := js.keyValueStore.Set(ctx, getJourneyKey(jID), journey)
 	if err != nil {
 		log.Errorf("Error creating new journey. Error: %+v", err)
-		return model.Journey[T]{}, novato_errors.InternalSystemError(ctx)
+		return model.Journey[T]{}, errors.StoreUnavailableError()
 	}
 	log.Infof("Created new journey with jID: %s", jID)
 	return journey, nil
@@ -56,14 +57,19 @@ func (js journeyStore[T]) Get(ctx context.Context, jID string) (model.Journey[T]
 	log.Infof("Fetching journey with jID: %s", jID)
 	journey, err := js.keyValueStore.Get(ctx, getJourneyKey(jID))
 
+	var journeyDataErr *JourneyDataError
+	if stderrors.As(err, &journeyDataErr) {
+		log.Errorf("Unable to read data of journey %s. Error: %+v", jID, err)
+		return model.Journey[T]{}, errors.JourneyDataError(journeyDataErr.Error())
+	}
 	if err != nil {
 		log.Errorf("Error fetching journey. Error: %+v", err)
-		return model.Journey[T]{}, novato_errors.InternalSystemError(ctx)
+		return model.Journey[T]{}, errors.StoreUnavailableError()
 	}
 
 	if journey == nil {
 		log.Error("Journey does not exist.")
-		return model.Journey[T]{}, errors.BypassError().WithMessage("journey not found")
+		return model.Journey[T]{}, errors.JourneyNotFoundError(jID)
 	}
 
 	return *journey, nil
@@ -76,7 +82,7 @@ func (js journeyStore[T]) Save(ctx context.Context, journey model.Journey[T]) *n
 	err := js.keyValueStore.Set(ctx, getJourneyKey(journey.JID), journey)
 	if err != nil {
 		log.Errorf("Error saving journey. Error: %+v", err)
-		return novato_errors.InternalSystemError(ctx)
+		return errors.StoreUnavailableError()
 	}
 	return nil
 }
@@ -88,7 +94,7 @@ func (js journeyStore[T]) Delete(ctx context.Context, jID string) *novato_errors
 	err := js.keyValueStore.Del(ctx, getJourneyKey(jID))
 	if err != nil {
 		log.Errorf("Error deleting journey. Error: %+v", err)
-		return novato_errors.InternalSystemError(ctx)
+		return errors.StoreUnavailableError()
 	}
 	return nil
 }
@@ -8,7 +8,6 @@ import (
 	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
 	"github.com/Novato-Now/novato-fsm/mocks"
 	"github.com/Novato-Now/novato-fsm/model"
-	novato_errors "github.com/Novato-Now/novato-utils/errors"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 )
@@ -65,7 +64,7 @@ func (suite *journeyStoreTestSuite) TestCreate_ShouldReturnError_WhenKeyValueSto
 	journey, err := suite.journeyStore.Create(suite.ctx)
 
 	suite.Empty(journey)
-	suite.Equal(novato_errors.InternalSystemError(suite.ctx), err)
+	suite.Equal(fsmErrors.StoreUnavailableError(), err)
 }
 
 func (suite *journeyStoreTestSuite) TestGet_ShouldReturnNoError_WhenKeyValueStoreReturnsNoError() {
@@ -92,7 +91,7 @@ func (suite *journeyStoreTestSuite) TestGet_ShouldReturnError_WhenKeyValueStoreR
 	journey, err := suite.journeyStore.Get(suite.ctx, "new-uuid")
 
 	suite.Empty(journey)
-	suite.Equal(fsmErrors.BypassError().WithMessage("journey not found"), err)
+	suite.Equal(fsmErrors.JourneyNotFoundError("new-uuid"), err)
 }
 
 func (suite *journeyStoreTestSuite) TestGet_ShouldReturnError_WhenKeyValueStoreReturnsError() {
@@ -104,7 +103,19 @@ func (suite *journeyStoreTestSuite) TestGet_ShouldReturnError_WhenKeyValueStoreR
 	journey, err := suite.journeyStore.Get(suite.ctx, "new-uuid")
 
 	suite.Empty(journey)
-	suite.Equal(novato_errors.InternalSystemError(suite.ctx), err)
+	suite.Equal(fsmErrors.StoreUnavailableError(), err)
+}
+
+func (suite *journeyStoreTestSuite) TestGet_ShouldReturnJourneyDataError_WhenJourneyDataCannotBeUpcast() {
+	suite.mockKeyValueStore.EXPECT().
+		Get(suite.ctx, "FSM_JOURNEY_new-uuid").
+		Return(nil, &JourneyDataError{Reason: "journey data schema version 3 is newer than the current version 2"}).
+		Times(1)
+
+	journey, err := suite.journeyStore.Get(suite.ctx, "new-uuid")
+
+	suite.Empty(journey)
+	suite.Equal(fsmErrors.JourneyDataError("journey data schema version 3 is newer than the current version 2"), err)
 }
 
 func (suite *journeyStoreTestSuite) TestSave_ShouldReturnNoError_WhenKeyValueStoreReturnsNoError() {
@@ -131,7 +142,7 @@ func (suite *journeyStoreTestSuite) TestSave_ShouldReturnError_WhenKeyValueStore
 
 	err := suite.journeyStore.Save(suite.ctx, journey)
 
-	suite.Equal(novato_errors.InternalSystemError(suite.ctx), err)
+	suite.Equal(fsmErrors.StoreUnavailableError(), err)
 }
 
 func (suite *journeyStoreTestSuite) TestDelete_ShouldReturnNoError_WhenKeyValueStoreReturnsNoError() {
@@ -154,5 +165,5 @@ func (suite *journeyStoreTestSuite) TestDelete_ShouldReturnError_WhenKeyValueSto
 
 	err := suite.journeyStore.Delete(suite.ctx, "new-uuid")
 
-	suite.Equal(novato_errors.InternalSystemError(suite.ctx), err)
+	suite.Equal(fsmErrors.StoreUnavailableError(), err)
 }
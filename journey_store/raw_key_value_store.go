@@ -0,0 +1,14 @@
+package journeystore
+
+import (
+	"context"
+)
+
+//go:generate mockgen -destination=../mocks/mock_raw_key_value_store.go -package=mocks -source=raw_key_value_store.go
+
+// RawKeyValueStore stores serialized journeys. Get returns nil when the key does not exist.
+type RawKeyValueStore interface {
+	Set(ctx context.Context, key string, value []byte) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	Del(ctx context.Context, key string) error
+}
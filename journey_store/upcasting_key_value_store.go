@@ -0,0 +1,102 @@
+package journeystore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Novato-Now/novato-fsm/model"
+)
+
+// Upcaster converts serialized journey data from one schema version to the next.
+type Upcaster func(ctx context.Context, data json.RawMessage) (json.RawMessage, error)
+
+// JourneyDataError reports stored journey data that cannot be brought to the current schema version. Unlike a
+// failure to reach the store, it does not go away when the read is retried.
+type JourneyDataError struct {
+	Reason string
+	Err    error
+}
+
+func (e *JourneyDataError) Error() string {
+	if e.Err == nil {
+		return e.Reason
+	}
+	return fmt.Sprintf("%s: %v", e.Reason, e.Err)
+}
+
+func (e *JourneyDataError) Unwrap() error {
+	return e.Err
+}
+
+type upcastingKeyValueStore[T any] struct {
+	rawKeyValueStore RawKeyValueStore
+	upcasters        []Upcaster
+}
+
+// NewUpcastingKeyValueStore serializes journeys as JSON into rawKeyValueStore. The upcaster at index i upgrades
+// data from schema version i to i+1, so the current schema version is len(upcasters). Journeys are stamped with
+// the current version on Set, and data stored with an older version is upcast on Get.
+func NewUpcastingKeyValueStore[T any](rawKeyValueStore RawKeyValueStore, upcasters ...Upcaster) KeyValueStore[T] {
+	return upcastingKeyValueStore[T]{rawKeyValueStore: rawKeyValueStore, upcasters: upcasters}
+}
+
+func (s upcastingKeyValueStore[T]) Set(ctx context.Context, key string, value model.Journey[T]) error {
+	value.DataSchemaVersion = len(s.upcasters)
+	serializedJourney, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return s.rawKeyValueStore.Set(ctx, key, serializedJourney)
+}
+
+func (s upcastingKeyValueStore[T]) Get(ctx context.Context, key string) (*model.Journey[T], error) {
+	serializedJourney, err := s.rawKeyValueStore.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if serializedJourney == nil {
+		return nil, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(serializedJourney, &fields); err != nil {
+		return nil, err
+	}
+	var schemaVersion int
+	if versionField, ok := fields["data_schema_version"]; ok {
+		if err := json.Unmarshal(versionField, &schemaVersion); err != nil {
+			return nil, err
+		}
+	}
+	if schemaVersion > len(s.upcasters) {
+		return nil, &JourneyDataError{Reason: fmt.Sprintf("journey data schema version %d is newer than the current version %d", schemaVersion, len(s.upcasters))}
+	}
+
+	if schemaVersion < len(s.upcasters) {
+		data := fields["data"]
+		for version := schemaVersion; version < len(s.upcasters); version++ {
+			data, err = s.upcasters[version](ctx, data)
+			if err != nil {
+				return nil, &JourneyDataError{Reason: fmt.Sprintf("unable to upcast journey data from schema version %d", version), Err: err}
+			}
+		}
+		fields["data"] = data
+		delete(fields, "data_schema_version")
+		serializedJourney, err = json.Marshal(fields)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var journey model.Journey[T]
+	if err := json.Unmarshal(serializedJourney, &journey); err != nil {
+		return nil, err
+	}
+	journey.DataSchemaVersion = len(s.upcasters)
+	return &journey, nil
+}
+
+func (s upcastingKeyValueStore[T]) Del(ctx context.Context, key string) error {
+	return s.rawKeyValueStore.Del(ctx, key)
+}
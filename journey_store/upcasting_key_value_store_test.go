@@ -0,0 +1,153 @@
+package journeystore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Novato-Now/novato-fsm/mocks"
+	"github.com/Novato-Now/novato-fsm/model"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type testProfile struct {
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+}
+
+func renameNameToFullName(ctx context.Context, data json.RawMessage) (json.RawMessage, error) {
+	var v0 struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(data, &v0); err != nil {
+		return nil, err
+	}
+	return json.Marshal(map[string]string{"full_name": v0.Name})
+}
+
+func splitFullName(ctx context.Context, data json.RawMessage) (json.RawMessage, error) {
+	var v1 struct {
+		FullName string `json:"full_name"`
+	}
+	if err := json.Unmarshal(data, &v1); err != nil {
+		return nil, err
+	}
+	firstName, lastName, ok := strings.Cut(v1.FullName, " ")
+	if !ok {
+		return nil, errors.New("full name has no last name")
+	}
+	return json.Marshal(testProfile{FirstName: firstName, LastName: lastName})
+}
+
+type upcastingKeyValueStoreTestSuite struct {
+	suite.Suite
+	mockCtrl             *gomock.Controller
+	mockRawKeyValueStore *mocks.MockRawKeyValueStore
+	keyValueStore        KeyValueStore[testProfile]
+	ctx                  context.Context
+}
+
+func TestUpcastingKeyValueStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(upcastingKeyValueStoreTestSuite))
+}
+
+func (suite *upcastingKeyValueStoreTestSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.mockRawKeyValueStore = mocks.NewMockRawKeyValueStore(suite.mockCtrl)
+	suite.ctx = context.Background()
+	suite.keyValueStore = NewUpcastingKeyValueStore[testProfile](suite.mockRawKeyValueStore, renameNameToFullName, splitFullName)
+}
+
+func (suite *upcastingKeyValueStoreTestSuite) TestSet_ShouldStampCurrentSchemaVersion() {
+	suite.mockRawKeyValueStore.EXPECT().
+		Set(suite.ctx, "some-key", []byte(`{"jID":"some-uuid","current_stage":"Init","last_checkpoint_stage":"","data":{"first_name":"Jane","last_name":"Doe"},"data_schema_version":2}`)).
+		Return(nil).
+		Times(1)
+
+	err := suite.keyValueStore.Set(suite.ctx, "some-key", model.Journey[testProfile]{JID: "some-uuid", CurrentStage: "Init", Data: testProfile{FirstName: "Jane", LastName: "Doe"}})
+
+	suite.Nil(err)
+}
+
+func (suite *upcastingKeyValueStoreTestSuite) TestGet_ShouldApplyEveryUpcaster_WhenDataHasNoSchemaVersion() {
+	suite.mockRawKeyValueStore.EXPECT().
+		Get(suite.ctx, "some-key").
+		Return([]byte(`{"jID":"some-uuid","current_stage":"Init","data":{"name":"Jane Doe"}}`), nil).
+		Times(1)
+
+	journey, err := suite.keyValueStore.Get(suite.ctx, "some-key")
+
+	suite.Equal(&model.Journey[testProfile]{JID: "some-uuid", CurrentStage: "Init", Data: testProfile{FirstName: "Jane", LastName: "Doe"}, DataSchemaVersion: 2}, journey)
+	suite.Nil(err)
+}
+
+func (suite *upcastingKeyValueStoreTestSuite) TestGet_ShouldApplyRemainingUpcasters_WhenDataIsOnIntermediateVersion() {
+	suite.mockRawKeyValueStore.EXPECT().
+		Get(suite.ctx, "some-key").
+		Return([]byte(`{"jID":"some-uuid","current_stage":"Init","data":{"full_name":"Jane Doe"},"data_schema_version":1}`), nil).
+		Times(1)
+
+	journey, err := suite.keyValueStore.Get(suite.ctx, "some-key")
+
+	suite.Equal(&model.Journey[testProfile]{JID: "some-uuid", CurrentStage: "Init", Data: testProfile{FirstName: "Jane", LastName: "Doe"}, DataSchemaVersion: 2}, journey)
+	suite.Nil(err)
+}
+
+func (suite *upcastingKeyValueStoreTestSuite) TestGet_ShouldNotUpcast_WhenDataIsOnCurrentVersion() {
+	suite.mockRawKeyValueStore.EXPECT().
+		Get(suite.ctx, "some-key").
+		Return([]byte(`{"jID":"some-uuid","current_stage":"Init","data":{"first_name":"Jane","last_name":"Doe"},"data_schema_version":2}`), nil).
+		Times(1)
+
+	journey, err := suite.keyValueStore.Get(suite.ctx, "some-key")
+
+	suite.Equal(&model.Journey[testProfile]{JID: "some-uuid", CurrentStage: "Init", Data: testProfile{FirstName: "Jane", LastName: "Doe"}, DataSchemaVersion: 2}, journey)
+	suite.Nil(err)
+}
+
+func (suite *upcastingKeyValueStoreTestSuite) TestGet_ShouldReturnNil_WhenKeyDoesNotExist() {
+	suite.mockRawKeyValueStore.EXPECT().Get(suite.ctx, "some-key").Return(nil, nil).Times(1)
+
+	journey, err := suite.keyValueStore.Get(suite.ctx, "some-key")
+
+	suite.Nil(journey)
+	suite.Nil(err)
+}
+
+func (suite *upcastingKeyValueStoreTestSuite) TestGet_ShouldReturnError_WhenUpcasterFails() {
+	suite.mockRawKeyValueStore.EXPECT().
+		Get(suite.ctx, "some-key").
+		Return([]byte(`{"jID":"some-uuid","data":{"full_name":"Jane"},"data_schema_version":1}`), nil).
+		Times(1)
+
+	journey, err := suite.keyValueStore.Get(suite.ctx, "some-key")
+
+	suite.Nil(journey)
+	suite.EqualError(err, "unable to upcast journey data from schema version 1: full name has no last name")
+	suite.IsType(&JourneyDataError{}, err)
+}
+
+func (suite *upcastingKeyValueStoreTestSuite) TestGet_ShouldReturnError_WhenSchemaVersionIsNewerThanCurrent() {
+	suite.mockRawKeyValueStore.EXPECT().
+		Get(suite.ctx, "some-key").
+		Return([]byte(`{"jID":"some-uuid","data":{},"data_schema_version":3}`), nil).
+		Times(1)
+
+	journey, err := suite.keyValueStore.Get(suite.ctx, "some-key")
+
+	suite.Nil(journey)
+	suite.Equal(&JourneyDataError{Reason: "journey data schema version 3 is newer than the current version 2"}, err)
+}
+
+func (suite *upcastingKeyValueStoreTestSuite) TestUpcasters_ShouldConvertEachSchemaStep() {
+	v1, err := renameNameToFullName(suite.ctx, json.RawMessage(`{"name":"Jane Doe"}`))
+	suite.Nil(err)
+	suite.JSONEq(`{"full_name":"Jane Doe"}`, string(v1))
+
+	v2, err := splitFullName(suite.ctx, v1)
+	suite.Nil(err)
+	suite.JSONEq(`{"first_name":"Jane","last_name":"Doe"}`, string(v2))
+}
@@ -0,0 +1,78 @@
+package lint
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/Novato-Now/novato-fsm/model"
+)
+
+const (
+	ExitOK     = 0
+	ExitIssues = 1
+	ExitUsage  = 2
+)
+
+// Main runs fsmlint with the given command line arguments and returns the process exit code.
+func Main(args []string, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet("fsmlint", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	file := flags.String("file", "", "path of a declarative JSON flow definition")
+	flowName := flags.String("flow", "", "name of a flow registered with lint.Register")
+	list := flags.Bool("list", false, "list registered flows")
+	if err := flags.Parse(args); err != nil {
+		return ExitUsage
+	}
+
+	if *list {
+		for _, name := range registeredFlowNames() {
+			fmt.Fprintln(stdout, name)
+		}
+		return ExitOK
+	}
+
+	var initialState model.FsmState
+	var nonInitStates []model.FsmState
+	var globalEvents []model.NextAvailableEvent
+	switch {
+	case *file != "" && *flowName == "":
+		data, err := os.ReadFile(*file)
+		if err != nil {
+			fmt.Fprintf(stderr, "fsmlint: %v\n", err)
+			return ExitUsage
+		}
+		definition, err := ParseDefinition(data)
+		if err != nil {
+			fmt.Fprintf(stderr, "fsmlint: %v\n", err)
+			return ExitUsage
+		}
+		initialState, nonInitStates, err = definition.FsmStates()
+		if err != nil {
+			fmt.Fprintf(stderr, "fsmlint: %v\n", err)
+			return ExitUsage
+		}
+		globalEvents = definition.FsmGlobalEvents()
+	case *flowName != "" && *file == "":
+		flow, ok := lookupFlow(*flowName)
+		if !ok {
+			fmt.Fprintf(stderr, "fsmlint: flow %q is not registered\n", *flowName)
+			return ExitUsage
+		}
+		initialState, nonInitStates, globalEvents = flow.initialState, flow.nonInitStates, flow.globalEvents
+	default:
+		fmt.Fprintln(stderr, "fsmlint: exactly one of -file or -flow is required")
+		flags.Usage()
+		return ExitUsage
+	}
+
+	issues := Lint(initialState, nonInitStates, globalEvents...)
+	for _, issue := range issues {
+		fmt.Fprintln(stdout, issue)
+	}
+	if len(issues) > 0 {
+		return ExitIssues
+	}
+	return ExitOK
+}
@@ -0,0 +1,110 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Novato-Now/novato-fsm/model"
+)
+
+// Definition is the declarative JSON form of a flow. It carries the structure of the flow only;
+// handlers, validators and actions are left unset.
+type Definition struct {
+	InitialState string            `json:"initial_state"`
+	States       []StateDefinition `json:"states"`
+	GlobalEvents []EventDefinition `json:"global_events,omitempty"`
+}
+
+type StateDefinition struct {
+	Name                 string                      `json:"name"`
+	NextScreen           string                      `json:"next_screen,omitempty"`
+	IsCheckpoint         bool                        `json:"is_checkpoint,omitempty"`
+	IsJoin               bool                        `json:"is_join,omitempty"`
+	IsAsync              bool                        `json:"is_async,omitempty"`
+	CompletionEvents     []string                    `json:"completion_events,omitempty"`
+	ExcludedGlobalEvents []string                    `json:"excluded_global_events,omitempty"`
+	TerminalStatus       model.JourneyStatus         `json:"terminal_status,omitempty"`
+	Events               []EventDefinition           `json:"events,omitempty"`
+	ParallelRegions      []RegionDefinition          `json:"parallel_regions,omitempty"`
+	ErrorTransitions     []ErrorTransitionDefinition `json:"error_transitions,omitempty"`
+}
+
+type EventDefinition struct {
+	Event       string `json:"event"`
+	Destination string `json:"destination"`
+}
+
+type ErrorTransitionDefinition struct {
+	ErrorCode   string `json:"error_code,omitempty"`
+	Destination string `json:"destination"`
+}
+
+type RegionDefinition struct {
+	Name             string   `json:"name"`
+	InitialStateName string   `json:"initial_state"`
+	FinalStateNames  []string `json:"final_states"`
+}
+
+func ParseDefinition(data []byte) (Definition, error) {
+	var definition Definition
+	if err := json.Unmarshal(data, &definition); err != nil {
+		return Definition{}, fmt.Errorf("invalid flow definition: %w", err)
+	}
+	return definition, nil
+}
+
+// FsmStates converts the definition into the states accepted by service.NewFsmService.
+func (d Definition) FsmStates() (model.FsmState, []model.FsmState, error) {
+	var initialState model.FsmState
+	var nonInitStates []model.FsmState
+	var foundInitialState bool
+	for _, stateDefinition := range d.States {
+		state := model.FsmState{
+			Name:                 stateDefinition.Name,
+			NextScreen:           stateDefinition.NextScreen,
+			IsCheckpoint:         stateDefinition.IsCheckpoint,
+			IsJoin:               stateDefinition.IsJoin,
+			IsAsync:              stateDefinition.IsAsync,
+			CompletionEvents:     stateDefinition.CompletionEvents,
+			ExcludedGlobalEvents: stateDefinition.ExcludedGlobalEvents,
+			TerminalStatus:       stateDefinition.TerminalStatus,
+		}
+		for _, eventDefinition := range stateDefinition.Events {
+			state.NextAvailableEvents = append(state.NextAvailableEvents, model.NextAvailableEvent{
+				Event:                eventDefinition.Event,
+				DestinationStateName: eventDefinition.Destination,
+			})
+		}
+		for _, errorTransitionDefinition := range stateDefinition.ErrorTransitions {
+			state.ErrorTransitions = append(state.ErrorTransitions, model.ErrorTransition{
+				ErrorCode:            errorTransitionDefinition.ErrorCode,
+				DestinationStateName: errorTransitionDefinition.Destination,
+			})
+		}
+		for _, regionDefinition := range stateDefinition.ParallelRegions {
+			state.ParallelRegions = append(state.ParallelRegions, model.ParallelRegion(regionDefinition))
+		}
+		if state.Name == d.InitialState && !foundInitialState {
+			initialState = state
+			foundInitialState = true
+			continue
+		}
+		nonInitStates = append(nonInitStates, state)
+	}
+	if !foundInitialState {
+		return model.FsmState{}, nil, fmt.Errorf("initial state %q is not defined", d.InitialState)
+	}
+	return initialState, nonInitStates, nil
+}
+
+// FsmGlobalEvents converts the global events of the definition into the transitions accepted by service.WithGlobalEvents.
+func (d Definition) FsmGlobalEvents() []model.NextAvailableEvent {
+	var globalEvents []model.NextAvailableEvent
+	for _, eventDefinition := range d.GlobalEvents {
+		globalEvents = append(globalEvents, model.NextAvailableEvent{
+			Event:                eventDefinition.Event,
+			DestinationStateName: eventDefinition.Destination,
+		})
+	}
+	return globalEvents
+}
@@ -0,0 +1,187 @@
+package lint
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Novato-Now/novato-fsm/constants"
+	"github.com/Novato-Now/novato-fsm/model"
+)
+
+const (
+	RuleUnknownDestination = "unknown-destination"
+	RuleDeadEnd            = "dead-end"
+	RuleUnreachable        = "unreachable"
+	RuleMissingBack        = "missing-back"
+	RuleForwardBack        = "forward-back"
+	RuleMissingNextScreen  = "missing-next-screen"
+	RuleReservedEventName  = "reserved-event-name"
+)
+
+var reservedEvents = []string{
+	constants.EventNameStart,
+	constants.EventNameResume,
+	constants.EventNameBack,
+	constants.EventNameTransitionComplete,
+	constants.EventNamePending,
+}
+
+type Issue struct {
+	Rule      string
+	StateName string
+	Message   string
+}
+
+func (i Issue) String() string {
+	if i.StateName == "" {
+		return fmt.Sprintf("%s: %s", i.Rule, i.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s", i.StateName, i.Rule, i.Message)
+}
+
+// Lint reports structural problems of a flow definition. Global events, as registered with service.WithGlobalEvents,
+// count as outgoing events of every state that does not exclude them. Issues of global events come first and have
+// no state name; the others are ordered by state, in definition order.
+func Lint(initialState model.FsmState, nonInitStates []model.FsmState, globalEvents ...model.NextAvailableEvent) []Issue {
+	states := append([]model.FsmState{initialState}, nonInitStates...)
+	stateMap := make(map[string]model.FsmState, len(states))
+	regionFinalStates := make(map[string]bool)
+	for _, state := range states {
+		stateMap[state.Name] = state
+		for _, region := range state.ParallelRegions {
+			for _, finalStateName := range region.FinalStateNames {
+				regionFinalStates[finalStateName] = true
+			}
+		}
+	}
+	depths := forwardDepths(initialState.Name, stateMap, globalEvents)
+
+	var issues []Issue
+	for _, globalEvent := range globalEvents {
+		if isReservedEvent(globalEvent.Event) {
+			issues = append(issues, Issue{Rule: RuleReservedEventName, Message: fmt.Sprintf("global event %q collides with the reserved events %s", globalEvent.Event, strings.Join(reservedEvents, ", "))})
+		}
+		if _, ok := stateMap[globalEvent.DestinationStateName]; !ok {
+			issues = append(issues, Issue{Rule: RuleUnknownDestination, Message: fmt.Sprintf("global event %q leads to unknown state %q", globalEvent.Event, globalEvent.DestinationStateName)})
+		}
+	}
+	for _, state := range states {
+		report := func(rule string, format string, args ...any) {
+			issues = append(issues, Issue{Rule: rule, StateName: state.Name, Message: fmt.Sprintf(format, args...)})
+		}
+
+		var hasForwardEvent, hasBack bool
+		for _, globalEvent := range applicableGlobalEvents(state, globalEvents) {
+			if globalEvent.Event == constants.EventNameBack {
+				hasBack = true
+			} else {
+				hasForwardEvent = true
+			}
+		}
+		for _, nextAvailableEvent := range state.NextAvailableEvents {
+			if nextAvailableEvent.Event == constants.EventNameBack {
+				hasBack = true
+			} else {
+				hasForwardEvent = true
+			}
+			if isReservedEvent(nextAvailableEvent.Event) {
+				report(RuleReservedEventName, "event %q collides with the reserved events %s", nextAvailableEvent.Event, strings.Join(reservedEvents, ", "))
+			}
+			destination, ok := stateMap[nextAvailableEvent.DestinationStateName]
+			if !ok {
+				report(RuleUnknownDestination, "event %q leads to unknown state %q", nextAvailableEvent.Event, nextAvailableEvent.DestinationStateName)
+				continue
+			}
+			if nextAvailableEvent.Event == constants.EventNameBack {
+				sourceDepth, sourceReachable := depths[state.Name]
+				destinationDepth, destinationReachable := depths[destination.Name]
+				if sourceReachable && destinationReachable && destinationDepth >= sourceDepth {
+					report(RuleForwardBack, "back event leads to %q which is not before this state", destination.Name)
+				}
+			}
+		}
+
+		for _, errorTransition := range state.ErrorTransitions {
+			if _, ok := stateMap[errorTransition.DestinationStateName]; !ok {
+				report(RuleUnknownDestination, "error transition leads to unknown state %q", errorTransition.DestinationStateName)
+			}
+		}
+
+		_, reachable := depths[state.Name]
+		if !reachable {
+			report(RuleUnreachable, "state cannot be reached from initial state %q", initialState.Name)
+		}
+		if !hasForwardEvent && state.TerminalStatus == "" && !regionFinalStates[state.Name] {
+			report(RuleDeadEnd, "non-terminal state has no outgoing events")
+		}
+		if state.IsCheckpoint && !hasBack && state.Name != initialState.Name && state.TerminalStatus == "" {
+			report(RuleMissingBack, "checkpoint state has no back event")
+		}
+		if state.NextScreen == "" {
+			report(RuleMissingNextScreen, "state has no next screen")
+		}
+	}
+	return issues
+}
+
+// forwardDepths returns the shortest distance of each reachable state from the initial state, ignoring back events.
+func forwardDepths(initialStateName string, states map[string]model.FsmState, globalEvents []model.NextAvailableEvent) map[string]int {
+	depths := map[string]int{initialStateName: 0}
+	queue := []string{initialStateName}
+	for len(queue) > 0 {
+		stateName := queue[0]
+		queue = queue[1:]
+		state, ok := states[stateName]
+		if !ok {
+			continue
+		}
+
+		var nextStateNames []string
+		for _, nextAvailableEvent := range slices.Concat(state.NextAvailableEvents, applicableGlobalEvents(state, globalEvents)) {
+			if nextAvailableEvent.Event != constants.EventNameBack {
+				nextStateNames = append(nextStateNames, nextAvailableEvent.DestinationStateName)
+			}
+		}
+		for _, region := range state.ParallelRegions {
+			nextStateNames = append(nextStateNames, region.InitialStateName)
+		}
+		for _, errorTransition := range state.ErrorTransitions {
+			nextStateNames = append(nextStateNames, errorTransition.DestinationStateName)
+		}
+		for _, nextStateName := range nextStateNames {
+			if _, visited := depths[nextStateName]; visited {
+				continue
+			}
+			depths[nextStateName] = depths[stateName] + 1
+			queue = append(queue, nextStateName)
+		}
+	}
+	return depths
+}
+
+// applicableGlobalEvents returns the global events a state accepts, which are those it neither excludes nor defines itself.
+func applicableGlobalEvents(state model.FsmState, globalEvents []model.NextAvailableEvent) []model.NextAvailableEvent {
+	var applicable []model.NextAvailableEvent
+	for _, globalEvent := range globalEvents {
+		if slices.Contains(state.ExcludedGlobalEvents, globalEvent.Event) {
+			continue
+		}
+		if slices.ContainsFunc(state.NextAvailableEvents, func(nextAvailableEvent model.NextAvailableEvent) bool {
+			return nextAvailableEvent.Event == globalEvent.Event
+		}) {
+			continue
+		}
+		applicable = append(applicable, globalEvent)
+	}
+	return applicable
+}
+
+func isReservedEvent(event string) bool {
+	if event == constants.EventNameBack {
+		return false
+	}
+	return slices.ContainsFunc(reservedEvents, func(reservedEvent string) bool {
+		return strings.EqualFold(reservedEvent, event)
+	})
+}
@@ -0,0 +1,210 @@
+package lint
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Novato-Now/novato-fsm/model"
+	"github.com/stretchr/testify/suite"
+)
+
+const testDefinition = `{
+	"initial_state": "Welcome",
+	"states": [
+		{"name": "Welcome", "next_screen": "WelcomeScreen", "is_checkpoint": true, "events": [{"event": "Next", "destination": "Details"}]},
+		{"name": "Details", "next_screen": "DetailsScreen", "is_checkpoint": true, "events": [{"event": "Back", "destination": "Welcome"}, {"event": "Submit", "destination": "Done"}]},
+		{"name": "Done", "next_screen": "DoneScreen", "terminal_status": "SUCCEEDED"}
+	]
+}`
+
+type lintTestSuite struct {
+	suite.Suite
+}
+
+func TestLintTestSuite(t *testing.T) {
+	suite.Run(t, new(lintTestSuite))
+}
+
+func (suite *lintTestSuite) TestLint_ShouldReturnNoIssues_WhenFlowIsWellFormed() {
+	definition, err := ParseDefinition([]byte(testDefinition))
+	suite.Nil(err)
+	initialState, nonInitStates, err := definition.FsmStates()
+	suite.Nil(err)
+
+	suite.Empty(Lint(initialState, nonInitStates))
+}
+
+func (suite *lintTestSuite) TestLint_ShouldReportIssues_WhenFlowIsMalformed() {
+	initialState := model.FsmState{
+		Name:       "Welcome",
+		NextScreen: "WelcomeScreen",
+		NextAvailableEvents: []model.NextAvailableEvent{
+			{Event: "Next", DestinationStateName: "Details"},
+			{Event: "resume", DestinationStateName: "Details"},
+		},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "Details",
+			NextScreen:   "DetailsScreen",
+			IsCheckpoint: true,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "Submit", DestinationStateName: "Review"},
+				{Event: "Skip", DestinationStateName: "Missing"},
+			},
+		},
+		{
+			Name:                "Review",
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Back", DestinationStateName: "Stuck"}},
+		},
+		{
+			Name:       "Stuck",
+			NextScreen: "StuckScreen",
+		},
+		{
+			Name:           "Orphan",
+			NextScreen:     "OrphanScreen",
+			TerminalStatus: model.JourneyStatusFailed,
+		},
+	}
+
+	suite.Equal(
+		[]Issue{
+			{Rule: RuleReservedEventName, StateName: "Welcome", Message: `event "resume" collides with the reserved events Start, Resume, Back, TransitionComplete, Pending`},
+			{Rule: RuleUnknownDestination, StateName: "Details", Message: `event "Skip" leads to unknown state "Missing"`},
+			{Rule: RuleMissingBack, StateName: "Details", Message: "checkpoint state has no back event"},
+			{Rule: RuleDeadEnd, StateName: "Review", Message: "non-terminal state has no outgoing events"},
+			{Rule: RuleMissingNextScreen, StateName: "Review", Message: "state has no next screen"},
+			{Rule: RuleUnreachable, StateName: "Stuck", Message: `state cannot be reached from initial state "Welcome"`},
+			{Rule: RuleDeadEnd, StateName: "Stuck", Message: "non-terminal state has no outgoing events"},
+			{Rule: RuleUnreachable, StateName: "Orphan", Message: `state cannot be reached from initial state "Welcome"`},
+		},
+		Lint(initialState, nonInitStates),
+	)
+}
+
+func (suite *lintTestSuite) TestLint_ShouldReportForwardBack_WhenBackLeadsToLaterState() {
+	initialState := model.FsmState{
+		Name:                "Welcome",
+		NextScreen:          "WelcomeScreen",
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Details"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:       "Details",
+			NextScreen: "DetailsScreen",
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "Back", DestinationStateName: "Done"},
+				{Event: "Submit", DestinationStateName: "Done"},
+			},
+		},
+		{Name: "Done", NextScreen: "DoneScreen", TerminalStatus: model.JourneyStatusSucceeded},
+	}
+
+	suite.Equal(
+		[]Issue{{Rule: RuleForwardBack, StateName: "Details", Message: `back event leads to "Done" which is not before this state`}},
+		Lint(initialState, nonInitStates),
+	)
+}
+
+func (suite *lintTestSuite) TestMain_ShouldReportIssuesFromFile() {
+	path := filepath.Join(suite.T().TempDir(), "flow.json")
+	suite.Nil(os.WriteFile(path, []byte(`{"initial_state": "Welcome", "states": [{"name": "Welcome", "next_screen": "WelcomeScreen"}]}`), 0o600))
+	var stdout, stderr bytes.Buffer
+
+	exitCode := Main([]string{"-file", path}, &stdout, &stderr)
+
+	suite.Equal(ExitIssues, exitCode)
+	suite.Equal("Welcome: dead-end: non-terminal state has no outgoing events\n", stdout.String())
+	suite.Empty(stderr.String())
+}
+
+func (suite *lintTestSuite) TestMain_ShouldLintRegisteredFlow() {
+	definition, err := ParseDefinition([]byte(testDefinition))
+	suite.Nil(err)
+	initialState, nonInitStates, err := definition.FsmStates()
+	suite.Nil(err)
+	Register("onboarding", initialState, nonInitStates)
+	var stdout, stderr bytes.Buffer
+
+	exitCode := Main([]string{"-flow", "onboarding"}, &stdout, &stderr)
+
+	suite.Equal(ExitOK, exitCode)
+	suite.Empty(stdout.String())
+}
+
+func (suite *lintTestSuite) TestMain_ShouldReturnUsageError_WhenNoSourceIsGiven() {
+	var stdout, stderr bytes.Buffer
+
+	exitCode := Main(nil, &stdout, &stderr)
+
+	suite.Equal(ExitUsage, exitCode)
+	suite.Contains(stderr.String(), "exactly one of -file or -flow is required")
+}
+
+func (suite *lintTestSuite) TestLint_ShouldTreatErrorTransitionsAsEdges() {
+	definition, err := ParseDefinition([]byte(`{
+		"initial_state": "Welcome",
+		"states": [
+			{"name": "Welcome", "next_screen": "WelcomeScreen", "events": [{"event": "Pay", "destination": "Payment"}]},
+			{"name": "Payment", "next_screen": "PaymentScreen", "events": [{"event": "Paid", "destination": "Done"}],
+				"error_transitions": [{"error_code": "PAYMENT_DECLINED", "destination": "PaymentFailed"}, {"destination": "Missing"}]},
+			{"name": "PaymentFailed", "next_screen": "PaymentFailedScreen", "terminal_status": "FAILED"},
+			{"name": "Done", "next_screen": "DoneScreen", "terminal_status": "SUCCEEDED"}
+		]
+	}`))
+	suite.Nil(err)
+	initialState, nonInitStates, err := definition.FsmStates()
+	suite.Nil(err)
+
+	suite.Equal(
+		[]Issue{{Rule: RuleUnknownDestination, StateName: "Payment", Message: `error transition leads to unknown state "Missing"`}},
+		Lint(initialState, nonInitStates),
+	)
+}
+
+func (suite *lintTestSuite) TestLint_ShouldTreatGlobalEventsAsEdgesOfStatesThatDoNotExcludeThem() {
+	initialState := model.FsmState{
+		Name:                "Welcome",
+		NextScreen:          "WelcomeScreen",
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Details"}},
+	}
+	nonInitStates := []model.FsmState{
+		{Name: "Details", NextScreen: "DetailsScreen"},
+		{Name: "Review", NextScreen: "ReviewScreen", ExcludedGlobalEvents: []string{"Cancel", "Help"}},
+		{Name: "Cancelled", NextScreen: "CancelledScreen", TerminalStatus: model.JourneyStatusFailed},
+	}
+
+	suite.Equal(
+		[]Issue{
+			{Rule: RuleUnknownDestination, Message: `global event "Help" leads to unknown state "Missing"`},
+			{Rule: RuleUnreachable, StateName: "Review", Message: `state cannot be reached from initial state "Welcome"`},
+			{Rule: RuleDeadEnd, StateName: "Review", Message: "non-terminal state has no outgoing events"},
+		},
+		Lint(initialState, nonInitStates,
+			model.NextAvailableEvent{Event: "Cancel", DestinationStateName: "Cancelled"},
+			model.NextAvailableEvent{Event: "Help", DestinationStateName: "Missing"},
+		),
+	)
+}
+
+func (suite *lintTestSuite) TestMain_ShouldLintGlobalEventsFromFile() {
+	path := filepath.Join(suite.T().TempDir(), "flow.json")
+	suite.Nil(os.WriteFile(path, []byte(`{
+		"initial_state": "Welcome",
+		"global_events": [{"event": "Cancel", "destination": "Cancelled"}, {"event": "Help", "destination": "Missing"}],
+		"states": [
+			{"name": "Welcome", "next_screen": "WelcomeScreen", "excluded_global_events": ["Help"]},
+			{"name": "Cancelled", "next_screen": "CancelledScreen", "terminal_status": "FAILED"}
+		]
+	}`), 0o600))
+	var stdout, stderr bytes.Buffer
+
+	exitCode := Main([]string{"-file", path}, &stdout, &stderr)
+
+	suite.Equal(ExitIssues, exitCode)
+	suite.Equal("unknown-destination: global event \"Help\" leads to unknown state \"Missing\"\n", stdout.String())
+	suite.Empty(stderr.String())
+}
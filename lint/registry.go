@@ -0,0 +1,49 @@
+package lint
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/Novato-Now/novato-fsm/model"
+)
+
+type registeredFlow struct {
+	initialState  model.FsmState
+	nonInitStates []model.FsmState
+	globalEvents  []model.NextAvailableEvent
+}
+
+var (
+	registry   = make(map[string]registeredFlow)
+	registryMu sync.Mutex
+)
+
+// Register makes a Go flow definition available to fsmlint under name. It is meant to be called from the
+// init function of a registration package compiled into the linter. Global events are those passed to
+// service.WithGlobalEvents.
+func Register(name string, initialState model.FsmState, nonInitStates []model.FsmState, globalEvents ...model.NextAvailableEvent) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	registry[name] = registeredFlow{initialState: initialState, nonInitStates: nonInitStates, globalEvents: globalEvents}
+}
+
+func registeredFlowNames() []string {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func lookupFlow(name string) (registeredFlow, bool) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	flow, ok := registry[name]
+	return flow, ok
+}
@@ -0,0 +1,196 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	journeystore "github.com/Novato-Now/novato-fsm/journey_store"
+	"github.com/Novato-Now/novato-fsm/model"
+	novato_errors "github.com/Novato-Now/novato-utils/errors"
+	"github.com/Novato-Now/novato-utils/logging"
+)
+
+// Plan moves journeys pinned to FromVersion onto the Target flow definition. Stages missing from StateMapping
+// keep their name; checkpoints missing from CheckpointMapping are mapped through StateMapping.
+type Plan[T any] struct {
+	FromVersion       string
+	Target            model.FlowDefinition
+	StateMapping      map[string]string
+	CheckpointMapping map[string]string
+	UpgradeData       func(ctx context.Context, data T) (T, error)
+}
+
+type Migrator[T any] interface {
+	MigrateJourney(ctx context.Context, journey model.Journey[T], dryRun bool) (migration model.JourneyMigration, err *novato_errors.Error)
+	Run(ctx context.Context, dryRun bool, batchSize int) (report model.MigrationReport, err *novato_errors.Error)
+}
+
+type migrator[T any] struct {
+	plan          Plan[T]
+	targetStates  map[string]model.FsmState
+	journeyStore  journeystore.JourneyStore[T]
+	journeyLister journeystore.JourneyLister[T]
+}
+
+func NewMigrator[T any](plan Plan[T], journeyStore journeystore.JourneyStore[T], journeyLister journeystore.JourneyLister[T]) (Migrator[T], *novato_errors.Error) {
+	targetStates := make(map[string]model.FsmState, len(plan.Target.NonInitStates)+1)
+	targetStates[plan.Target.InitialState.Name] = plan.Target.InitialState
+	for _, state := range plan.Target.NonInitStates {
+		targetStates[state.Name] = state
+	}
+	for oldStage, newStage := range plan.StateMapping {
+		if _, ok := targetStates[newStage]; !ok {
+			return nil, fsmErrors.InvalidConfigurationError(fmt.Sprintf("stage %s is mapped to unknown state %s", oldStage, newStage))
+		}
+	}
+	for oldCheckpoint, newCheckpoint := range plan.CheckpointMapping {
+		if !targetStates[newCheckpoint].IsCheckpoint {
+			return nil, fsmErrors.InvalidConfigurationError(fmt.Sprintf("checkpoint %s is mapped to %s which is not a checkpoint", oldCheckpoint, newCheckpoint))
+		}
+	}
+
+	return migrator[T]{
+		plan:          plan,
+		targetStates:  targetStates,
+		journeyStore:  journeyStore,
+		journeyLister: journeyLister,
+	}, nil
+}
+
+// MigrateJourney maps a single journey onto the target flow and saves it unless dryRun is set.
+// Journeys pinned to another version are returned unchanged with an empty ToStage.
+func (m migrator[T]) MigrateJourney(ctx context.Context, journey model.Journey[T], dryRun bool) (model.JourneyMigration, *novato_errors.Error) {
+	log := logging.GetLogger(ctx)
+	journeyMigration := model.JourneyMigration{
+		JID:            journey.JID,
+		FromStage:      journey.CurrentStage,
+		FromCheckpoint: journey.LastCheckpointStage,
+	}
+	if journey.FlowVersion != m.plan.FromVersion {
+		return journeyMigration, nil
+	}
+
+	migratedJourney, err := m.migrate(ctx, journey)
+	if err != nil {
+		log.Errorf("Unable to migrate journey %s. Error: %+v", journey.JID, err)
+		return journeyMigration, err
+	}
+	journeyMigration.ToStage = migratedJourney.CurrentStage
+	journeyMigration.ToCheckpoint = migratedJourney.LastCheckpointStage
+	if dryRun {
+		return journeyMigration, nil
+	}
+
+	log.Infof("Migrating journey %s from version %s to %s", journey.JID, m.plan.FromVersion, m.plan.Target.Version)
+	err = m.journeyStore.Save(ctx, migratedJourney)
+	if err != nil {
+		log.Errorf("Unable to save migrated journey %s. Error: %+v", journey.JID, err)
+		return journeyMigration, err
+	}
+	return journeyMigration, nil
+}
+
+// Run migrates every listed journey in batches of batchSize. A failing journey is reported and does not stop the run.
+// Unless dryRun is set, each journey is read again before it is migrated, so that changes saved after it was listed
+// are migrated rather than overwritten.
+func (m migrator[T]) Run(ctx context.Context, dryRun bool, batchSize int) (model.MigrationReport, *novato_errors.Error) {
+	log := logging.GetLogger(ctx)
+	report := model.MigrationReport{DryRun: dryRun}
+	cursor := ""
+	for {
+		journeys, nextCursor, err := m.journeyLister.List(ctx, cursor, batchSize)
+		if err != nil {
+			log.Errorf("Unable to list journeys. Error: %+v", err)
+			return report, err
+		}
+		for _, journey := range journeys {
+			report.Scanned++
+			journeyMigration, err := m.migrateListedJourney(ctx, journey, dryRun)
+			switch {
+			case err != nil:
+				report.Failed++
+				journeyMigration.Error = err.Error()
+			case journeyMigration.ToStage == "":
+				report.Skipped++
+				continue
+			default:
+				report.Migrated++
+			}
+			report.Journeys = append(report.Journeys, journeyMigration)
+		}
+		if nextCursor == "" {
+			break
+		}
+		cursor = nextCursor
+	}
+	log.Infof("Migration finished. Scanned: %d, migrated: %d, skipped: %d, failed: %d", report.Scanned, report.Migrated, report.Skipped, report.Failed)
+	return report, nil
+}
+
+func (m migrator[T]) migrateListedJourney(ctx context.Context, journey model.Journey[T], dryRun bool) (model.JourneyMigration, *novato_errors.Error) {
+	if dryRun || journey.FlowVersion != m.plan.FromVersion {
+		return m.MigrateJourney(ctx, journey, dryRun)
+	}
+	log := logging.GetLogger(ctx)
+	storedJourney, err := m.journeyStore.Get(ctx, journey.JID)
+	if fsmErrors.HasCode(err, fsmErrors.CodeJourneyNotFound) {
+		log.Infof("Journey %s was deleted after it was listed", journey.JID)
+		return model.JourneyMigration{JID: journey.JID, FromStage: journey.CurrentStage, FromCheckpoint: journey.LastCheckpointStage}, nil
+	}
+	if err != nil {
+		log.Errorf("Unable to fetch journey %s. Error: %+v", journey.JID, err)
+		return model.JourneyMigration{JID: journey.JID, FromStage: journey.CurrentStage, FromCheckpoint: journey.LastCheckpointStage}, err
+	}
+	return m.MigrateJourney(ctx, storedJourney, dryRun)
+}
+
+func (m migrator[T]) migrate(ctx context.Context, journey model.Journey[T]) (model.Journey[T], *novato_errors.Error) {
+	currentStage, ok := m.mapStage(journey.CurrentStage)
+	if !ok {
+		return model.Journey[T]{}, fsmErrors.ValidationError().WithMessage(fmt.Sprintf("stage %s does not exist in version %s", journey.CurrentStage, m.plan.Target.Version))
+	}
+	journey.CurrentStage = currentStage
+
+	if journey.LastCheckpointStage != "" {
+		checkpoint, ok := m.plan.CheckpointMapping[journey.LastCheckpointStage]
+		if !ok {
+			checkpoint, _ = m.mapStage(journey.LastCheckpointStage)
+		}
+		if !m.targetStates[checkpoint].IsCheckpoint {
+			return model.Journey[T]{}, fsmErrors.ValidationError().WithMessage(fmt.Sprintf("checkpoint %s is not a checkpoint in version %s", journey.LastCheckpointStage, m.plan.Target.Version))
+		}
+		journey.LastCheckpointStage = checkpoint
+	}
+
+	if journey.Regions != nil {
+		regions := make(map[string]model.JourneyRegion, len(journey.Regions))
+		for name, region := range journey.Regions {
+			regionStage, ok := m.mapStage(region.CurrentStage)
+			if !ok {
+				return model.Journey[T]{}, fsmErrors.ValidationError().WithMessage(fmt.Sprintf("region stage %s does not exist in version %s", region.CurrentStage, m.plan.Target.Version))
+			}
+			region.CurrentStage = regionStage
+			regions[name] = region
+		}
+		journey.Regions = regions
+	}
+
+	if m.plan.UpgradeData != nil {
+		data, upgradeErr := m.plan.UpgradeData(ctx, journey.Data)
+		if upgradeErr != nil {
+			return model.Journey[T]{}, fsmErrors.ValidationError().WithMessage(fmt.Sprintf("unable to upgrade journey data: %v", upgradeErr))
+		}
+		journey.Data = data
+	}
+	journey.FlowVersion = m.plan.Target.Version
+	return journey, nil
+}
+
+func (m migrator[T]) mapStage(stage string) (string, bool) {
+	if mappedStage, ok := m.plan.StateMapping[stage]; ok {
+		stage = mappedStage
+	}
+	_, ok := m.targetStates[stage]
+	return stage, ok
+}
@@ -0,0 +1,186 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/mocks"
+	"github.com/Novato-Now/novato-fsm/model"
+	"github.com/Novato-Now/novato-utils/constants"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type testJourneyData struct {
+	Name     string
+	FullName string
+}
+
+type migratorTestSuite struct {
+	suite.Suite
+	mockCtrl          *gomock.Controller
+	mockJourneyStore  *mocks.MockJourneyStore[testJourneyData]
+	mockJourneyLister *mocks.MockJourneyLister[testJourneyData]
+	ctx               context.Context
+}
+
+func TestMigratorTestSuite(t *testing.T) {
+	suite.Run(t, new(migratorTestSuite))
+}
+
+func (suite *migratorTestSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.mockJourneyStore = mocks.NewMockJourneyStore[testJourneyData](suite.mockCtrl)
+	suite.mockJourneyLister = mocks.NewMockJourneyLister[testJourneyData](suite.mockCtrl)
+	suite.ctx = context.WithValue(context.Background(), constants.ServiceNameKey, "FSM")
+}
+
+func (suite *migratorTestSuite) plan() Plan[testJourneyData] {
+	return Plan[testJourneyData]{
+		FromVersion: "v1",
+		Target: model.FlowDefinition{
+			Version:      "v2",
+			InitialState: model.FsmState{Name: "Welcome", IsCheckpoint: true},
+			NonInitStates: []model.FsmState{
+				{Name: "Profile", IsCheckpoint: true},
+				{Name: "Review"},
+			},
+		},
+		StateMapping:      map[string]string{"Details": "Profile", "Confirm": "Review"},
+		CheckpointMapping: map[string]string{"Init": "Welcome"},
+		UpgradeData: func(ctx context.Context, data testJourneyData) (testJourneyData, error) {
+			if data.Name == "" {
+				return data, errors.New("name is missing")
+			}
+			data.FullName = data.Name
+			data.Name = ""
+			return data, nil
+		},
+	}
+}
+
+func (suite *migratorTestSuite) TestNewMigrator_ShouldReturnConfigurationError_WhenMappingTargetsUnknownState() {
+	plan := suite.plan()
+	plan.StateMapping["Details"] = "Missing"
+
+	migrator, err := NewMigrator(plan, suite.mockJourneyStore, suite.mockJourneyLister)
+
+	suite.Nil(migrator)
+	suite.Equal(fsmErrors.InvalidConfigurationError("stage Details is mapped to unknown state Missing"), err)
+}
+
+func (suite *migratorTestSuite) TestMigrateJourney_ShouldMapStagesAndUpgradeData() {
+	migrator, err := NewMigrator(suite.plan(), suite.mockJourneyStore, suite.mockJourneyLister)
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{
+		JID:                 "some-uuid",
+		CurrentStage:        "Confirm",
+		LastCheckpointStage: "Init",
+		Data:                testJourneyData{Name: "Jane"},
+		FlowVersion:         "v1",
+	}
+	expectedJourney := model.Journey[testJourneyData]{
+		JID:                 "some-uuid",
+		CurrentStage:        "Review",
+		LastCheckpointStage: "Welcome",
+		Data:                testJourneyData{FullName: "Jane"},
+		FlowVersion:         "v2",
+	}
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	journeyMigration, err := migrator.MigrateJourney(suite.ctx, journey, false)
+
+	suite.Equal(model.JourneyMigration{JID: "some-uuid", FromStage: "Confirm", ToStage: "Review", FromCheckpoint: "Init", ToCheckpoint: "Welcome"}, journeyMigration)
+	suite.Nil(err)
+}
+
+func (suite *migratorTestSuite) TestRun_ShouldReportWithoutSaving_WhenDryRun() {
+	migrator, err := NewMigrator(suite.plan(), suite.mockJourneyStore, suite.mockJourneyLister)
+	suite.Nil(err)
+
+	suite.mockJourneyLister.EXPECT().List(suite.ctx, "", 2).Return([]model.Journey[testJourneyData]{
+		{JID: "jid-1", CurrentStage: "Details", LastCheckpointStage: "Details", Data: testJourneyData{Name: "Jane"}, FlowVersion: "v1"},
+		{JID: "jid-2", CurrentStage: "Profile", FlowVersion: "v2"},
+	}, "cursor-1", nil).Times(1)
+	suite.mockJourneyLister.EXPECT().List(suite.ctx, "cursor-1", 2).Return([]model.Journey[testJourneyData]{
+		{JID: "jid-3", CurrentStage: "Removed", FlowVersion: "v1"},
+		{JID: "jid-4", CurrentStage: "Details", FlowVersion: "v1"},
+	}, "", nil).Times(1)
+
+	report, err := migrator.Run(suite.ctx, true, 2)
+
+	suite.Equal(
+		model.MigrationReport{
+			DryRun:   true,
+			Scanned:  4,
+			Migrated: 1,
+			Skipped:  1,
+			Failed:   2,
+			Journeys: []model.JourneyMigration{
+				{JID: "jid-1", FromStage: "Details", ToStage: "Profile", FromCheckpoint: "Details", ToCheckpoint: "Profile"},
+				{JID: "jid-3", FromStage: "Removed", Error: fsmErrors.ValidationError().WithMessage("stage Removed does not exist in version v2").Error()},
+				{JID: "jid-4", FromStage: "Details", Error: fsmErrors.ValidationError().WithMessage("unable to upgrade journey data: name is missing").Error()},
+			},
+		},
+		report,
+	)
+	suite.Nil(err)
+}
+
+func (suite *migratorTestSuite) TestRun_ShouldMigrateStoredJourney_WhenJourneyChangedAfterListing() {
+	migrator, err := NewMigrator(suite.plan(), suite.mockJourneyStore, suite.mockJourneyLister)
+	suite.Nil(err)
+
+	storedJourney := model.Journey[testJourneyData]{
+		JID:                 "jid-1",
+		CurrentStage:        "Confirm",
+		LastCheckpointStage: "Details",
+		Data:                testJourneyData{Name: "Janet"},
+		FlowVersion:         "v1",
+	}
+	expectedJourney := model.Journey[testJourneyData]{
+		JID:                 "jid-1",
+		CurrentStage:        "Review",
+		LastCheckpointStage: "Profile",
+		Data:                testJourneyData{FullName: "Janet"},
+		FlowVersion:         "v2",
+	}
+
+	suite.mockJourneyLister.EXPECT().List(suite.ctx, "", 10).Return([]model.Journey[testJourneyData]{
+		{JID: "jid-1", CurrentStage: "Details", LastCheckpointStage: "Details", Data: testJourneyData{Name: "Jane"}, FlowVersion: "v1"},
+		{JID: "jid-2", CurrentStage: "Details", Data: testJourneyData{Name: "John"}, FlowVersion: "v1"},
+	}, "", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "jid-1").Return(storedJourney, nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "jid-2").Return(model.Journey[testJourneyData]{}, fsmErrors.JourneyNotFoundError("jid-2")).Times(1)
+
+	report, err := migrator.Run(suite.ctx, false, 10)
+
+	suite.Equal(
+		model.MigrationReport{
+			Scanned:  2,
+			Migrated: 1,
+			Skipped:  1,
+			Journeys: []model.JourneyMigration{
+				{JID: "jid-1", FromStage: "Confirm", ToStage: "Review", FromCheckpoint: "Details", ToCheckpoint: "Profile"},
+			},
+		},
+		report,
+	)
+	suite.Nil(err)
+}
+
+func (suite *migratorTestSuite) TestRun_ShouldReturnError_WhenListingFails() {
+	migrator, err := NewMigrator(suite.plan(), suite.mockJourneyStore, suite.mockJourneyLister)
+	suite.Nil(err)
+
+	suite.mockJourneyLister.EXPECT().List(suite.ctx, "", 10).Return(nil, "", fsmErrors.BypassError()).Times(1)
+
+	report, err := migrator.Run(suite.ctx, false, 10)
+
+	suite.Equal(model.MigrationReport{}, report)
+	suite.Equal(fsmErrors.BypassError(), err)
+}
@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: admin_service.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=../mocks/mock_admin_service.go -package=mocks -source=admin_service.go
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Novato-Now/novato-fsm/model"
+	novato_errors "github.com/Novato-Now/novato-utils/errors"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAdminService is a mock of AdminService interface.
+type MockAdminService[T any] struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminServiceMockRecorder[T]
+}
+
+// MockAdminServiceMockRecorder is the mock recorder for MockAdminService.
+type MockAdminServiceMockRecorder[T any] struct {
+	mock *MockAdminService[T]
+}
+
+// NewMockAdminService creates a new mock instance.
+func NewMockAdminService[T any](ctrl *gomock.Controller) *MockAdminService[T] {
+	mock := &MockAdminService[T]{ctrl: ctrl}
+	mock.recorder = &MockAdminServiceMockRecorder[T]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminService[T]) EXPECT() *MockAdminServiceMockRecorder[T] {
+	return m.recorder
+}
+
+// DeleteJourney mocks base method.
+func (m *MockAdminService[T]) DeleteJourney(ctx context.Context, jID string, actor model.AdminActor) *novato_errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteJourney", ctx, jID, actor)
+	ret0, _ := ret[0].(*novato_errors.Error)
+	return ret0
+}
+
+// DeleteJourney indicates an expected call of DeleteJourney.
+func (mr *MockAdminServiceMockRecorder[T]) DeleteJourney(ctx, jID, actor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteJourney", reflect.TypeOf((*MockAdminService[T])(nil).DeleteJourney), ctx, jID, actor)
+}
+
+// ForceTransition mocks base method.
+func (m *MockAdminService[T]) ForceTransition(ctx context.Context, jID, stateName string, runRevisit bool, actor model.AdminActor) (model.Journey[T], *novato_errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForceTransition", ctx, jID, stateName, runRevisit, actor)
+	ret0, _ := ret[0].(model.Journey[T])
+	ret1, _ := ret[1].(*novato_errors.Error)
+	return ret0, ret1
+}
+
+// ForceTransition indicates an expected call of ForceTransition.
+func (mr *MockAdminServiceMockRecorder[T]) ForceTransition(ctx, jID, stateName, runRevisit, actor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceTransition", reflect.TypeOf((*MockAdminService[T])(nil).ForceTransition), ctx, jID, stateName, runRevisit, actor)
+}
+
+// GetJourney mocks base method.
+func (m *MockAdminService[T]) GetJourney(ctx context.Context, jID string, actor model.AdminActor) (model.Journey[T], *novato_errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJourney", ctx, jID, actor)
+	ret0, _ := ret[0].(model.Journey[T])
+	ret1, _ := ret[1].(*novato_errors.Error)
+	return ret0, ret1
+}
+
+// GetJourney indicates an expected call of GetJourney.
+func (mr *MockAdminServiceMockRecorder[T]) GetJourney(ctx, jID, actor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJourney", reflect.TypeOf((*MockAdminService[T])(nil).GetJourney), ctx, jID, actor)
+}
+
+// PatchJourneyData mocks base method.
+func (m *MockAdminService[T]) PatchJourneyData(ctx context.Context, jID string, patch map[string]any, actor model.AdminActor) (model.Journey[T], *novato_errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchJourneyData", ctx, jID, patch, actor)
+	ret0, _ := ret[0].(model.Journey[T])
+	ret1, _ := ret[1].(*novato_errors.Error)
+	return ret0, ret1
+}
+
+// PatchJourneyData indicates an expected call of PatchJourneyData.
+func (mr *MockAdminServiceMockRecorder[T]) PatchJourneyData(ctx, jID, patch, actor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchJourneyData", reflect.TypeOf((*MockAdminService[T])(nil).PatchJourneyData), ctx, jID, patch, actor)
+}
+
+// ResetCheckpoint mocks base method.
+func (m *MockAdminService[T]) ResetCheckpoint(ctx context.Context, jID, stateName string, actor model.AdminActor) (model.Journey[T], *novato_errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetCheckpoint", ctx, jID, stateName, actor)
+	ret0, _ := ret[0].(model.Journey[T])
+	ret1, _ := ret[1].(*novato_errors.Error)
+	return ret0, ret1
+}
+
+// ResetCheckpoint indicates an expected call of ResetCheckpoint.
+func (mr *MockAdminServiceMockRecorder[T]) ResetCheckpoint(ctx, jID, stateName, actor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetCheckpoint", reflect.TypeOf((*MockAdminService[T])(nil).ResetCheckpoint), ctx, jID, stateName, actor)
+}
@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: correlation_store.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=../mocks/mock_correlation_store.go -package=mocks -source=correlation_store.go
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCorrelationStore is a mock of CorrelationStore interface.
+type MockCorrelationStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockCorrelationStoreMockRecorder
+}
+
+// MockCorrelationStoreMockRecorder is the mock recorder for MockCorrelationStore.
+type MockCorrelationStoreMockRecorder struct {
+	mock *MockCorrelationStore
+}
+
+// NewMockCorrelationStore creates a new mock instance.
+func NewMockCorrelationStore(ctrl *gomock.Controller) *MockCorrelationStore {
+	mock := &MockCorrelationStore{ctrl: ctrl}
+	mock.recorder = &MockCorrelationStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCorrelationStore) EXPECT() *MockCorrelationStoreMockRecorder {
+	return m.recorder
+}
+
+// ClaimDelivery mocks base method.
+func (m *MockCorrelationStore) ClaimDelivery(ctx context.Context, correlationKey, deliveryID string, expiresAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDelivery", ctx, correlationKey, deliveryID, expiresAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDelivery indicates an expected call of ClaimDelivery.
+func (mr *MockCorrelationStoreMockRecorder) ClaimDelivery(ctx, correlationKey, deliveryID, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDelivery", reflect.TypeOf((*MockCorrelationStore)(nil).ClaimDelivery), ctx, correlationKey, deliveryID, expiresAt)
+}
+
+// Lookup mocks base method.
+func (m *MockCorrelationStore) Lookup(ctx context.Context, correlationKey string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lookup", ctx, correlationKey)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Lookup indicates an expected call of Lookup.
+func (mr *MockCorrelationStoreMockRecorder) Lookup(ctx, correlationKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lookup", reflect.TypeOf((*MockCorrelationStore)(nil).Lookup), ctx, correlationKey)
+}
+
+// Register mocks base method.
+func (m *MockCorrelationStore) Register(ctx context.Context, correlationKey, jID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", ctx, correlationKey, jID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockCorrelationStoreMockRecorder) Register(ctx, correlationKey, jID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockCorrelationStore)(nil).Register), ctx, correlationKey, jID)
+}
+
+// ReleaseDelivery mocks base method.
+func (m *MockCorrelationStore) ReleaseDelivery(ctx context.Context, correlationKey, deliveryID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseDelivery", ctx, correlationKey, deliveryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseDelivery indicates an expected call of ReleaseDelivery.
+func (mr *MockCorrelationStoreMockRecorder) ReleaseDelivery(ctx, correlationKey, deliveryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDelivery", reflect.TypeOf((*MockCorrelationStore)(nil).ReleaseDelivery), ctx, correlationKey, deliveryID)
+}
+
+// Remove mocks base method.
+func (m *MockCorrelationStore) Remove(ctx context.Context, correlationKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, correlationKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockCorrelationStoreMockRecorder) Remove(ctx, correlationKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockCorrelationStore)(nil).Remove), ctx, correlationKey)
+}
+
+// RemoveJourney mocks base method.
+func (m *MockCorrelationStore) RemoveJourney(ctx context.Context, jID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveJourney", ctx, jID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveJourney indicates an expected call of RemoveJourney.
+func (mr *MockCorrelationStoreMockRecorder) RemoveJourney(ctx, jID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveJourney", reflect.TypeOf((*MockCorrelationStore)(nil).RemoveJourney), ctx, jID)
+}
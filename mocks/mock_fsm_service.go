@@ -41,6 +41,21 @@ func (m *MockFsmService[T]) EXPECT() *MockFsmServiceMockRecorder[T] {
 	return m.recorder
 }
 
+// CompletePending mocks base method.
+func (m *MockFsmService[T]) CompletePending(ctx context.Context, request model.CompletionRequest) (model.FsmResponse, *novato_errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompletePending", ctx, request)
+	ret0, _ := ret[0].(model.FsmResponse)
+	ret1, _ := ret[1].(*novato_errors.Error)
+	return ret0, ret1
+}
+
+// CompletePending indicates an expected call of CompletePending.
+func (mr *MockFsmServiceMockRecorder[T]) CompletePending(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompletePending", reflect.TypeOf((*MockFsmService[T])(nil).CompletePending), ctx, request)
+}
+
 // Execute mocks base method.
 func (m *MockFsmService[T]) Execute(ctx context.Context, request model.FsmRequest) (model.FsmResponse, *novato_errors.Error) {
 	m.ctrl.T.Helper()
@@ -55,3 +70,48 @@ func (mr *MockFsmServiceMockRecorder[T]) Execute(ctx, request any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockFsmService[T])(nil).Execute), ctx, request)
 }
+
+// GetProgress mocks base method.
+func (m *MockFsmService[T]) GetProgress(ctx context.Context, jID string) (model.Progress, *novato_errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProgress", ctx, jID)
+	ret0, _ := ret[0].(model.Progress)
+	ret1, _ := ret[1].(*novato_errors.Error)
+	return ret0, ret1
+}
+
+// GetProgress indicates an expected call of GetProgress.
+func (mr *MockFsmServiceMockRecorder[T]) GetProgress(ctx, jID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProgress", reflect.TypeOf((*MockFsmService[T])(nil).GetProgress), ctx, jID)
+}
+
+// Ingest mocks base method.
+func (m *MockFsmService[T]) Ingest(ctx context.Context, event model.ExternalEvent) (model.IngestionResult, *novato_errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ingest", ctx, event)
+	ret0, _ := ret[0].(model.IngestionResult)
+	ret1, _ := ret[1].(*novato_errors.Error)
+	return ret0, ret1
+}
+
+// Ingest indicates an expected call of Ingest.
+func (mr *MockFsmServiceMockRecorder[T]) Ingest(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ingest", reflect.TypeOf((*MockFsmService[T])(nil).Ingest), ctx, event)
+}
+
+// Simulate mocks base method.
+func (m *MockFsmService[T]) Simulate(ctx context.Context, request model.FsmRequest) (model.SimulationResult, *novato_errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Simulate", ctx, request)
+	ret0, _ := ret[0].(model.SimulationResult)
+	ret1, _ := ret[1].(*novato_errors.Error)
+	return ret0, ret1
+}
+
+// Simulate indicates an expected call of Simulate.
+func (mr *MockFsmServiceMockRecorder[T]) Simulate(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Simulate", reflect.TypeOf((*MockFsmService[T])(nil).Simulate), ctx, request)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: journey_archiver.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=../mocks/mock_journey_archiver.go -package=mocks -source=journey_archiver.go
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Novato-Now/novato-fsm/model"
+	novato_errors "github.com/Novato-Now/novato-utils/errors"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockJourneyArchiver is a mock of JourneyArchiver interface.
+type MockJourneyArchiver[T any] struct {
+	ctrl     *gomock.Controller
+	recorder *MockJourneyArchiverMockRecorder[T]
+}
+
+// MockJourneyArchiverMockRecorder is the mock recorder for MockJourneyArchiver.
+type MockJourneyArchiverMockRecorder[T any] struct {
+	mock *MockJourneyArchiver[T]
+}
+
+// NewMockJourneyArchiver creates a new mock instance.
+func NewMockJourneyArchiver[T any](ctrl *gomock.Controller) *MockJourneyArchiver[T] {
+	mock := &MockJourneyArchiver[T]{ctrl: ctrl}
+	mock.recorder = &MockJourneyArchiverMockRecorder[T]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJourneyArchiver[T]) EXPECT() *MockJourneyArchiverMockRecorder[T] {
+	return m.recorder
+}
+
+// Archive mocks base method.
+func (m *MockJourneyArchiver[T]) Archive(ctx context.Context, journey model.Journey[T]) *novato_errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archive", ctx, journey)
+	ret0, _ := ret[0].(*novato_errors.Error)
+	return ret0
+}
+
+// Archive indicates an expected call of Archive.
+func (mr *MockJourneyArchiverMockRecorder[T]) Archive(ctx, journey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockJourneyArchiver[T])(nil).Archive), ctx, journey)
+}
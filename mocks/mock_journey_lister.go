@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: journey_lister.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=../mocks/mock_journey_lister.go -package=mocks -source=journey_lister.go
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Novato-Now/novato-fsm/model"
+	novato_errors "github.com/Novato-Now/novato-utils/errors"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockJourneyLister is a mock of JourneyLister interface.
+type MockJourneyLister[T any] struct {
+	ctrl     *gomock.Controller
+	recorder *MockJourneyListerMockRecorder[T]
+}
+
+// MockJourneyListerMockRecorder is the mock recorder for MockJourneyLister.
+type MockJourneyListerMockRecorder[T any] struct {
+	mock *MockJourneyLister[T]
+}
+
+// NewMockJourneyLister creates a new mock instance.
+func NewMockJourneyLister[T any](ctrl *gomock.Controller) *MockJourneyLister[T] {
+	mock := &MockJourneyLister[T]{ctrl: ctrl}
+	mock.recorder = &MockJourneyListerMockRecorder[T]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJourneyLister[T]) EXPECT() *MockJourneyListerMockRecorder[T] {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockJourneyLister[T]) List(ctx context.Context, cursor string, limit int) ([]model.Journey[T], string, *novato_errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, cursor, limit)
+	ret0, _ := ret[0].([]model.Journey[T])
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(*novato_errors.Error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockJourneyListerMockRecorder[T]) List(ctx, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockJourneyLister[T])(nil).List), ctx, cursor, limit)
+}
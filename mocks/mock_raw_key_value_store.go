@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: raw_key_value_store.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=../mocks/mock_raw_key_value_store.go -package=mocks -source=raw_key_value_store.go
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRawKeyValueStore is a mock of RawKeyValueStore interface.
+type MockRawKeyValueStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockRawKeyValueStoreMockRecorder
+}
+
+// MockRawKeyValueStoreMockRecorder is the mock recorder for MockRawKeyValueStore.
+type MockRawKeyValueStoreMockRecorder struct {
+	mock *MockRawKeyValueStore
+}
+
+// NewMockRawKeyValueStore creates a new mock instance.
+func NewMockRawKeyValueStore(ctrl *gomock.Controller) *MockRawKeyValueStore {
+	mock := &MockRawKeyValueStore{ctrl: ctrl}
+	mock.recorder = &MockRawKeyValueStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRawKeyValueStore) EXPECT() *MockRawKeyValueStoreMockRecorder {
+	return m.recorder
+}
+
+// Del mocks base method.
+func (m *MockRawKeyValueStore) Del(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Del", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Del indicates an expected call of Del.
+func (mr *MockRawKeyValueStoreMockRecorder) Del(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Del", reflect.TypeOf((*MockRawKeyValueStore)(nil).Del), ctx, key)
+}
+
+// Get mocks base method.
+func (m *MockRawKeyValueStore) Get(ctx context.Context, key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockRawKeyValueStoreMockRecorder) Get(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRawKeyValueStore)(nil).Get), ctx, key)
+}
+
+// Set mocks base method.
+func (m *MockRawKeyValueStore) Set(ctx context.Context, key string, value []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, key, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockRawKeyValueStoreMockRecorder) Set(ctx, key, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockRawKeyValueStore)(nil).Set), ctx, key, value)
+}
@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: scheduler.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=../mocks/mock_scheduler.go -package=mocks -source=scheduler.go
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Novato-Now/novato-fsm/model"
+	novato_errors "github.com/Novato-Now/novato-utils/errors"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockScheduler is a mock of Scheduler interface.
+type MockScheduler struct {
+	ctrl     *gomock.Controller
+	recorder *MockSchedulerMockRecorder
+}
+
+// MockSchedulerMockRecorder is the mock recorder for MockScheduler.
+type MockSchedulerMockRecorder struct {
+	mock *MockScheduler
+}
+
+// NewMockScheduler creates a new mock instance.
+func NewMockScheduler(ctrl *gomock.Controller) *MockScheduler {
+	mock := &MockScheduler{ctrl: ctrl}
+	mock.recorder = &MockSchedulerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduler) EXPECT() *MockSchedulerMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockScheduler) Cancel(ctx context.Context, jID string) *novato_errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, jID)
+	ret0, _ := ret[0].(*novato_errors.Error)
+	return ret0
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockSchedulerMockRecorder) Cancel(ctx, jID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockScheduler)(nil).Cancel), ctx, jID)
+}
+
+// Schedule mocks base method.
+func (m *MockScheduler) Schedule(ctx context.Context, event model.ScheduledEvent) *novato_errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Schedule", ctx, event)
+	ret0, _ := ret[0].(*novato_errors.Error)
+	return ret0
+}
+
+// Schedule indicates an expected call of Schedule.
+func (mr *MockSchedulerMockRecorder) Schedule(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockScheduler)(nil).Schedule), ctx, event)
+}
+
+// SetDispatcher mocks base method.
+func (m *MockScheduler) SetDispatcher(dispatcher func(context.Context, model.ScheduledEvent)) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetDispatcher", dispatcher)
+}
+
+// SetDispatcher indicates an expected call of SetDispatcher.
+func (mr *MockSchedulerMockRecorder) SetDispatcher(dispatcher any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDispatcher", reflect.TypeOf((*MockScheduler)(nil).SetDispatcher), dispatcher)
+}
@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: timer_store.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=../mocks/mock_timer_store.go -package=mocks -source=timer_store.go
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/Novato-Now/novato-fsm/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTimerStore is a mock of TimerStore interface.
+type MockTimerStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockTimerStoreMockRecorder
+}
+
+// MockTimerStoreMockRecorder is the mock recorder for MockTimerStore.
+type MockTimerStoreMockRecorder struct {
+	mock *MockTimerStore
+}
+
+// NewMockTimerStore creates a new mock instance.
+func NewMockTimerStore(ctrl *gomock.Controller) *MockTimerStore {
+	mock := &MockTimerStore{ctrl: ctrl}
+	mock.recorder = &MockTimerStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTimerStore) EXPECT() *MockTimerStoreMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockTimerStore) Add(ctx context.Context, event model.ScheduledEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockTimerStoreMockRecorder) Add(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockTimerStore)(nil).Add), ctx, event)
+}
+
+// Due mocks base method.
+func (m *MockTimerStore) Due(ctx context.Context, now time.Time) ([]model.ScheduledEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Due", ctx, now)
+	ret0, _ := ret[0].([]model.ScheduledEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Due indicates an expected call of Due.
+func (mr *MockTimerStoreMockRecorder) Due(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Due", reflect.TypeOf((*MockTimerStore)(nil).Due), ctx, now)
+}
+
+// Remove mocks base method.
+func (m *MockTimerStore) Remove(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockTimerStoreMockRecorder) Remove(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockTimerStore)(nil).Remove), ctx, id)
+}
+
+// RemoveForJourney mocks base method.
+func (m *MockTimerStore) RemoveForJourney(ctx context.Context, jID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveForJourney", ctx, jID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveForJourney indicates an expected call of RemoveForJourney.
+func (mr *MockTimerStoreMockRecorder) RemoveForJourney(ctx, jID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveForJourney", reflect.TypeOf((*MockTimerStore)(nil).RemoveForJourney), ctx, jID)
+}
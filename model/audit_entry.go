@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+type AuditAction string
+
+const (
+	AuditActionGetJourney       AuditAction = "GET_JOURNEY"
+	AuditActionForceTransition  AuditAction = "FORCE_TRANSITION"
+	AuditActionResetCheckpoint  AuditAction = "RESET_CHECKPOINT"
+	AuditActionPatchJourneyData AuditAction = "PATCH_JOURNEY_DATA"
+	AuditActionDeleteJourney    AuditAction = "DELETE_JOURNEY"
+)
+
+type AdminActor struct {
+	ID     string `json:"id"`
+	Reason string `json:"reason,omitempty"`
+}
+
+type AuditEntry struct {
+	JID       string      `json:"jID"`
+	Action    AuditAction `json:"action"`
+	Actor     AdminActor  `json:"actor"`
+	FromStage string      `json:"from_stage,omitempty"`
+	ToStage   string      `json:"to_stage,omitempty"`
+	Details   any         `json:"details,omitempty"`
+	At        time.Time   `json:"at"`
+}
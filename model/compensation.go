@@ -0,0 +1,14 @@
+package model
+
+import (
+	"context"
+
+	novato_errors "github.com/Novato-Now/novato-utils/errors"
+)
+
+type StateCompensation func(ctx context.Context, jID string, journeyData any) *novato_errors.Error
+
+type CompensationResult struct {
+	StateName string
+	Err       *novato_errors.Error
+}
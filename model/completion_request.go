@@ -0,0 +1,8 @@
+package model
+
+type CompletionRequest struct {
+	JID            string `json:"jID,omitempty"`
+	CorrelationKey string `json:"correlation_key,omitempty"`
+	Event          string `json:"event"`
+	Data           any    `json:"data,omitempty"`
+}
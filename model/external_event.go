@@ -0,0 +1,14 @@
+package model
+
+type ExternalEvent struct {
+	CorrelationKey string `json:"correlation_key"`
+	DeliveryID     string `json:"delivery_id,omitempty"`
+	Event          string `json:"event"`
+	Data           any    `json:"data,omitempty"`
+}
+
+type IngestionResult struct {
+	JID         string      `json:"jID"`
+	IsDuplicate bool        `json:"is_duplicate,omitempty"`
+	Response    FsmResponse `json:"response"`
+}
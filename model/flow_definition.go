@@ -0,0 +1,8 @@
+package model
+
+type FlowDefinition struct {
+	Version       string
+	InitialState  FsmState
+	NonInitStates []FsmState
+	GlobalEvents  []NextAvailableEvent
+}
@@ -1,7 +1,10 @@
 package model
 
 type FsmRequest struct {
-	JID   string `json:"jID"`
-	Event string `json:"event" binding:"required"`
-	Data  any    `json:"data"`
+	JID            string `json:"jID"`
+	Event          string `json:"event" binding:"required"`
+	Data           any    `json:"data"`
+	Region         string `json:"region"`
+	IdempotencyKey string `json:"idempotency_key"`
+	CallerID       string `json:"caller_id"`
 }
@@ -1,8 +1,19 @@
 package model
 
 type FsmResponse struct {
-	JID        string `json:"jID"`
-	Data       any    `json:"data,omitempty"`
-	NextScreen string `json:"next_screen,omitempty"`
-	MetaData   any    `json:"meta_data,omitempty"`
+	JID        string      `json:"jID"`
+	Data       any         `json:"data,omitempty"`
+	NextScreen string      `json:"next_screen,omitempty"`
+	MetaData   any         `json:"meta_data,omitempty"`
+	Navigation *Navigation `json:"navigation,omitempty"`
+	Progress   *Progress   `json:"progress,omitempty"`
+	IsPending  bool        `json:"is_pending,omitempty"`
+}
+
+type Navigation struct {
+	StateName       string   `json:"state_name"`
+	Region          string   `json:"region,omitempty"`
+	AvailableEvents []string `json:"available_events"`
+	CanGoBack       bool     `json:"can_go_back"`
+	IsCheckpoint    bool     `json:"is_checkpoint"`
 }
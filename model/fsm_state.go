@@ -1,23 +1,64 @@
 package model
 
 import (
+	"time"
+
 	"github.com/Novato-Now/novato-fsm/state_handler"
+	"github.com/Novato-Now/novato-fsm/validation"
 )
 
 type FsmState struct {
-	Name                string
-	StateHandler        state_handler.StateHandler
-	NextAvailableEvents []NextAvailableEvent
-	IsCheckpoint        bool
-	NextScreen          string
-	MetaData            any
+	Name                  string
+	StateHandler          state_handler.StateHandler
+	NextAvailableEvents   []NextAvailableEvent
+	IsCheckpoint          bool
+	NextScreen            string
+	MetaData              any
+	ParallelRegions       []ParallelRegion
+	IsJoin                bool
+	ExcludedGlobalEvents  []string
+	TerminalStatus        JourneyStatus
+	Timers                []StateTimer
+	OnEnter               StateAction
+	OnExit                StateAction
+	Compensate            StateCompensation
+	PartialProgressPolicy PartialProgressPolicy
+	RequestValidator      validation.RequestValidator
+	ProgressWeight        float64
+	IsPure                bool
+	ErrorTransitions      []ErrorTransition
+	RetryPolicy           *RetryPolicy
+	Timeout               time.Duration
+	IsAsync               bool
+	CompletionEvents      []string
 }
 
 type NextAvailableEvent struct {
 	Event                string
 	DestinationStateName string
+	RequestValidator     validation.RequestValidator
+}
+
+type ErrorTransition struct {
+	ErrorCode            string
+	DestinationStateName string
+}
+
+type ParallelRegion struct {
+	Name             string
+	InitialStateName string
+	FinalStateNames  []string
+}
+
+type StateTimer struct {
+	Name  string
+	After time.Duration
+	Event string
 }
 
 type FsmHooks[T any] struct {
 	OnAfterSaveJourney func(Journey[T])
+	OnJourneyCompleted func(Journey[T])
+	OnCompensated      func(jID string, results []CompensationResult)
+	OnHandlerAttempt   func(attempt HandlerAttempt)
 }
@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+type IdempotencyRecord struct {
+	RequestHash  string      `json:"request_hash"`
+	Response     FsmResponse `json:"response"`
+	ExpiresAt    time.Time   `json:"expires_at"`
+	IsInProgress bool        `json:"is_in_progress,omitempty"`
+}
+
+type IdempotencyScope struct {
+	JID      string `json:"jID,omitempty"`
+	CallerID string `json:"caller_id,omitempty"`
+}
@@ -1,8 +1,32 @@
 package model
 
+import "time"
+
+type JourneyStatus string
+
+const (
+	JourneyStatusSucceeded JourneyStatus = "SUCCEEDED"
+	JourneyStatusFailed    JourneyStatus = "FAILED"
+)
+
 type Journey[T any] struct {
-	JID                 string `json:"jID"`
-	CurrentStage        string `json:"current_stage"`
-	LastCheckpointStage string `json:"last_checkpoint_stage"`
-	Data                T      `json:"data"`
+	JID                 string                   `json:"jID"`
+	CurrentStage        string                   `json:"current_stage"`
+	LastCheckpointStage string                   `json:"last_checkpoint_stage"`
+	Data                T                        `json:"data"`
+	Regions             map[string]JourneyRegion `json:"regions,omitempty"`
+	Status              JourneyStatus            `json:"status,omitempty"`
+	CompletedAt         *time.Time               `json:"completed_at,omitempty"`
+	FlowVersion         string                   `json:"flow_version,omitempty"`
+	DataSchemaVersion   int                      `json:"data_schema_version,omitempty"`
+	IsPending           bool                     `json:"is_pending,omitempty"`
+}
+
+func (j Journey[T]) IsCompleted() bool {
+	return j.Status != ""
+}
+
+type JourneyRegion struct {
+	CurrentStage string `json:"current_stage"`
+	IsComplete   bool   `json:"is_complete"`
 }
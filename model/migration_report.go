@@ -0,0 +1,19 @@
+package model
+
+type MigrationReport struct {
+	DryRun   bool               `json:"dry_run"`
+	Scanned  int                `json:"scanned"`
+	Migrated int                `json:"migrated"`
+	Skipped  int                `json:"skipped"`
+	Failed   int                `json:"failed"`
+	Journeys []JourneyMigration `json:"journeys,omitempty"`
+}
+
+type JourneyMigration struct {
+	JID            string `json:"jID"`
+	FromStage      string `json:"from_stage"`
+	ToStage        string `json:"to_stage,omitempty"`
+	FromCheckpoint string `json:"from_checkpoint,omitempty"`
+	ToCheckpoint   string `json:"to_checkpoint,omitempty"`
+	Error          string `json:"error,omitempty"`
+}
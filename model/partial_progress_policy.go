@@ -0,0 +1,9 @@
+package model
+
+type PartialProgressPolicy string
+
+const (
+	PartialProgressPersistNothing             PartialProgressPolicy = "NOTHING"
+	PartialProgressPersistLastCheckpoint      PartialProgressPolicy = "LAST_CHECKPOINT"
+	PartialProgressPersistLastSuccessfulState PartialProgressPolicy = "LAST_SUCCESSFUL_STATE"
+)
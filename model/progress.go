@@ -0,0 +1,9 @@
+package model
+
+type Progress struct {
+	StepsCompleted    float64 `json:"steps_completed"`
+	MinStepsRemaining float64 `json:"min_steps_remaining"`
+	MaxStepsRemaining float64 `json:"max_steps_remaining"`
+	Percent           float64 `json:"percent"`
+	IsPending         bool    `json:"is_pending,omitempty"`
+}
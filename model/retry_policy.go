@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+
+	novato_errors "github.com/Novato-Now/novato-utils/errors"
+)
+
+type RetryPolicy struct {
+	MaxAttempts         int
+	InitialBackoff      time.Duration
+	MaxBackoff          time.Duration
+	Multiplier          float64
+	Jitter              float64
+	RetryableErrorCodes []string
+}
+
+type HandlerAttempt struct {
+	JID       string
+	StateName string
+	Method    string
+	Attempt   int
+	Err       *novato_errors.Error
+	WillRetry bool
+}
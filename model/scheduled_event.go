@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+type ScheduledEvent struct {
+	ID        string    `json:"id"`
+	JID       string    `json:"jID"`
+	StateName string    `json:"state_name"`
+	Event     string    `json:"event"`
+	DueAt     time.Time `json:"due_at"`
+}
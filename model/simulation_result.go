@@ -0,0 +1,10 @@
+package model
+
+type SimulationResult struct {
+	Path       []string `json:"path"`
+	NextScreen string   `json:"next_screen,omitempty"`
+	MetaData   any      `json:"meta_data,omitempty"`
+	Data       any      `json:"data,omitempty"`
+	IsPartial  bool     `json:"is_partial"`
+	IsPending  bool     `json:"is_pending,omitempty"`
+}
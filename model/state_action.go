@@ -0,0 +1,21 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	novato_errors "github.com/Novato-Now/novato-utils/errors"
+)
+
+type StateAction func(ctx context.Context, jID string, journeyData any) (updatedJourneyData any, err *novato_errors.Error)
+
+func TypedStateAction[T any](action func(ctx context.Context, jID string, journeyData T) (T, *novato_errors.Error)) StateAction {
+	return func(ctx context.Context, jID string, journeyData any) (any, *novato_errors.Error) {
+		typedJourneyData, ok := journeyData.(T)
+		if !ok {
+			return journeyData, fsmErrors.JourneyDataTypeError(fmt.Sprintf("%T", typedJourneyData), fmt.Sprintf("%T", journeyData))
+		}
+		return action(ctx, jID, typedJourneyData)
+	}
+}
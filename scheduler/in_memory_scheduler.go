@@ -0,0 +1,87 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Novato-Now/novato-fsm/model"
+	novato_errors "github.com/Novato-Now/novato-utils/errors"
+	"github.com/Novato-Now/novato-utils/logging"
+)
+
+type inMemoryScheduler struct {
+	dispatchCtx context.Context
+	dispatcher  func(ctx context.Context, event model.ScheduledEvent)
+	timers      map[string]map[string]*time.Timer
+	mu          sync.Mutex
+}
+
+func NewInMemoryScheduler(dispatchCtx context.Context) Scheduler {
+	return &inMemoryScheduler{
+		dispatchCtx: dispatchCtx,
+		timers:      make(map[string]map[string]*time.Timer),
+	}
+}
+
+func (s *inMemoryScheduler) SetDispatcher(dispatcher func(ctx context.Context, event model.ScheduledEvent)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dispatcher = dispatcher
+}
+
+func (s *inMemoryScheduler) Schedule(ctx context.Context, event model.ScheduledEvent) *novato_errors.Error {
+	log := logging.GetLogger(ctx)
+	log.Infof("Scheduling event %s for jID %s at %s", event.Event, event.JID, event.DueAt)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	journeyTimers, ok := s.timers[event.JID]
+	if !ok {
+		journeyTimers = make(map[string]*time.Timer)
+		s.timers[event.JID] = journeyTimers
+	}
+	if existingTimer, ok := journeyTimers[event.ID]; ok {
+		existingTimer.Stop()
+	}
+	journeyTimers[event.ID] = time.AfterFunc(time.Until(event.DueAt), func() {
+		s.fire(event)
+	})
+	return nil
+}
+
+func (s *inMemoryScheduler) Cancel(ctx context.Context, jID string) *novato_errors.Error {
+	log := logging.GetLogger(ctx)
+	log.Infof("Cancelling scheduled events for jID %s", jID)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, timer := range s.timers[jID] {
+		timer.Stop()
+	}
+	delete(s.timers, jID)
+	return nil
+}
+
+func (s *inMemoryScheduler) fire(event model.ScheduledEvent) {
+	log := logging.GetLogger(s.dispatchCtx)
+
+	s.mu.Lock()
+	journeyTimers, ok := s.timers[event.JID]
+	if ok {
+		delete(journeyTimers, event.ID)
+		if len(journeyTimers) == 0 {
+			delete(s.timers, event.JID)
+		}
+	}
+	dispatcher := s.dispatcher
+	s.mu.Unlock()
+
+	if dispatcher == nil {
+		log.Errorf("No dispatcher registered for scheduled event %s", event.ID)
+		return
+	}
+	dispatcher(s.dispatchCtx, event)
+}
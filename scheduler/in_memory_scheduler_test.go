@@ -0,0 +1,59 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Novato-Now/novato-fsm/model"
+	"github.com/stretchr/testify/suite"
+)
+
+type inMemorySchedulerTestSuite struct {
+	suite.Suite
+	ctx             context.Context
+	scheduler       Scheduler
+	dispatchedEvent chan model.ScheduledEvent
+}
+
+func TestInMemorySchedulerTestSuite(t *testing.T) {
+	suite.Run(t, new(inMemorySchedulerTestSuite))
+}
+
+func (suite *inMemorySchedulerTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.dispatchedEvent = make(chan model.ScheduledEvent, 1)
+	suite.scheduler = NewInMemoryScheduler(suite.ctx)
+	suite.scheduler.SetDispatcher(func(ctx context.Context, event model.ScheduledEvent) {
+		suite.dispatchedEvent <- event
+	})
+}
+
+func (suite *inMemorySchedulerTestSuite) TestSchedule_ShouldDispatchEvent_WhenEventIsDue() {
+	event := model.ScheduledEvent{ID: "some-id", JID: "some-uuid", StateName: "StateA", Event: "Expire", DueAt: time.Now().Add(10 * time.Millisecond)}
+
+	err := suite.scheduler.Schedule(suite.ctx, event)
+	suite.Nil(err)
+
+	select {
+	case dispatchedEvent := <-suite.dispatchedEvent:
+		suite.Equal(event, dispatchedEvent)
+	case <-time.After(time.Second):
+		suite.Fail("scheduled event was not dispatched")
+	}
+}
+
+func (suite *inMemorySchedulerTestSuite) TestCancel_ShouldNotDispatchEvent_WhenJourneyTimersAreCancelled() {
+	event := model.ScheduledEvent{ID: "some-id", JID: "some-uuid", StateName: "StateA", Event: "Expire", DueAt: time.Now().Add(10 * time.Millisecond)}
+
+	err := suite.scheduler.Schedule(suite.ctx, event)
+	suite.Nil(err)
+	err = suite.scheduler.Cancel(suite.ctx, "some-uuid")
+	suite.Nil(err)
+
+	select {
+	case <-suite.dispatchedEvent:
+		suite.Fail("cancelled event was dispatched")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
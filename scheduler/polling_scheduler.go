@@ -0,0 +1,111 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/model"
+	novato_errors "github.com/Novato-Now/novato-utils/errors"
+	"github.com/Novato-Now/novato-utils/logging"
+)
+
+var timeNow = time.Now
+
+type PollingScheduler interface {
+	Scheduler
+	Run(ctx context.Context)
+	Poll(ctx context.Context) *novato_errors.Error
+}
+
+type pollingScheduler struct {
+	timerStore TimerStore
+	interval   time.Duration
+	dispatcher func(ctx context.Context, event model.ScheduledEvent)
+	mu         sync.RWMutex
+}
+
+func NewPollingScheduler(timerStore TimerStore, interval time.Duration) PollingScheduler {
+	return &pollingScheduler{
+		timerStore: timerStore,
+		interval:   interval,
+	}
+}
+
+func (s *pollingScheduler) SetDispatcher(dispatcher func(ctx context.Context, event model.ScheduledEvent)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dispatcher = dispatcher
+}
+
+func (s *pollingScheduler) Schedule(ctx context.Context, event model.ScheduledEvent) *novato_errors.Error {
+	log := logging.GetLogger(ctx)
+	log.Infof("Scheduling event %s for jID %s at %s", event.Event, event.JID, event.DueAt)
+
+	err := s.timerStore.Add(ctx, event)
+	if err != nil {
+		log.Errorf("Error adding scheduled event. Error: %+v", err)
+		return fsmErrors.StoreUnavailableError()
+	}
+	return nil
+}
+
+func (s *pollingScheduler) Cancel(ctx context.Context, jID string) *novato_errors.Error {
+	log := logging.GetLogger(ctx)
+	log.Infof("Cancelling scheduled events for jID %s", jID)
+
+	err := s.timerStore.RemoveForJourney(ctx, jID)
+	if err != nil {
+		log.Errorf("Error removing scheduled events. Error: %+v", err)
+		return fsmErrors.StoreUnavailableError()
+	}
+	return nil
+}
+
+func (s *pollingScheduler) Run(ctx context.Context) {
+	log := logging.GetLogger(ctx)
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("Stopping polling scheduler.")
+			return
+		case <-ticker.C:
+			err := s.Poll(ctx)
+			if err != nil {
+				log.Errorf("Error polling scheduled events. Error: %+v", err)
+			}
+		}
+	}
+}
+
+func (s *pollingScheduler) Poll(ctx context.Context) *novato_errors.Error {
+	log := logging.GetLogger(ctx)
+
+	s.mu.RLock()
+	dispatcher := s.dispatcher
+	s.mu.RUnlock()
+	if dispatcher == nil {
+		log.Error("No dispatcher registered for polling scheduler.")
+		return fsmErrors.NotConfiguredError("dispatcher")
+	}
+
+	dueEvents, err := s.timerStore.Due(ctx, timeNow())
+	if err != nil {
+		log.Errorf("Error fetching due scheduled events. Error: %+v", err)
+		return fsmErrors.StoreUnavailableError()
+	}
+
+	for _, event := range dueEvents {
+		err = s.timerStore.Remove(ctx, event.ID)
+		if err != nil {
+			log.Errorf("Error removing scheduled event %s. Error: %+v", event.ID, err)
+			continue
+		}
+		dispatcher(ctx, event)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/mocks"
+	"github.com/Novato-Now/novato-fsm/model"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type pollingSchedulerTestSuite struct {
+	suite.Suite
+	mockCtrl         *gomock.Controller
+	mockTimerStore   *mocks.MockTimerStore
+	scheduler        PollingScheduler
+	dispatchedEvents []model.ScheduledEvent
+	now              time.Time
+	ctx              context.Context
+}
+
+func TestPollingSchedulerTestSuite(t *testing.T) {
+	suite.Run(t, new(pollingSchedulerTestSuite))
+}
+
+func (suite *pollingSchedulerTestSuite) SetupTest() {
+	suite.mockCtrl = gomock.NewController(suite.T())
+	suite.mockTimerStore = mocks.NewMockTimerStore(suite.mockCtrl)
+	suite.ctx = context.Background()
+	suite.now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time {
+		return suite.now
+	}
+	suite.T().Cleanup(func() { timeNow = time.Now })
+	suite.dispatchedEvents = nil
+
+	suite.scheduler = NewPollingScheduler(suite.mockTimerStore, time.Minute)
+	suite.scheduler.SetDispatcher(func(ctx context.Context, event model.ScheduledEvent) {
+		suite.dispatchedEvents = append(suite.dispatchedEvents, event)
+	})
+}
+
+func (suite *pollingSchedulerTestSuite) TestSchedule_ShouldReturnNoError_WhenTimerStoreReturnsNoError() {
+	event := model.ScheduledEvent{ID: "some-id", JID: "some-uuid", Event: "Expire", DueAt: suite.now}
+
+	suite.mockTimerStore.EXPECT().Add(suite.ctx, event).Return(nil).Times(1)
+
+	err := suite.scheduler.Schedule(suite.ctx, event)
+
+	suite.Nil(err)
+}
+
+func (suite *pollingSchedulerTestSuite) TestSchedule_ShouldReturnError_WhenTimerStoreReturnsError() {
+	event := model.ScheduledEvent{ID: "some-id", JID: "some-uuid", Event: "Expire", DueAt: suite.now}
+
+	suite.mockTimerStore.EXPECT().Add(suite.ctx, event).Return(errors.New("some-error")).Times(1)
+
+	err := suite.scheduler.Schedule(suite.ctx, event)
+
+	suite.Equal(fsmErrors.StoreUnavailableError(), err)
+}
+
+func (suite *pollingSchedulerTestSuite) TestCancel_ShouldRemoveJourneyTimers() {
+	suite.mockTimerStore.EXPECT().RemoveForJourney(suite.ctx, "some-uuid").Return(nil).Times(1)
+
+	err := suite.scheduler.Cancel(suite.ctx, "some-uuid")
+
+	suite.Nil(err)
+}
+
+func (suite *pollingSchedulerTestSuite) TestPoll_ShouldDispatchDueEvents_WhenTheyAreRemovedFromTimerStore() {
+	eventA := model.ScheduledEvent{ID: "id-a", JID: "uuid-a", Event: "Expire", DueAt: suite.now}
+	eventB := model.ScheduledEvent{ID: "id-b", JID: "uuid-b", Event: "Expire", DueAt: suite.now}
+
+	suite.mockTimerStore.EXPECT().Due(suite.ctx, suite.now).Return([]model.ScheduledEvent{eventA, eventB}, nil).Times(1)
+	suite.mockTimerStore.EXPECT().Remove(suite.ctx, "id-a").Return(nil).Times(1)
+	suite.mockTimerStore.EXPECT().Remove(suite.ctx, "id-b").Return(errors.New("some-error")).Times(1)
+
+	err := suite.scheduler.Poll(suite.ctx)
+
+	suite.Nil(err)
+	suite.Equal([]model.ScheduledEvent{eventA}, suite.dispatchedEvents)
+}
+
+func (suite *pollingSchedulerTestSuite) TestPoll_ShouldReturnError_WhenTimerStoreReturnsError() {
+	suite.mockTimerStore.EXPECT().Due(suite.ctx, suite.now).Return(nil, errors.New("some-error")).Times(1)
+
+	err := suite.scheduler.Poll(suite.ctx)
+
+	suite.Equal(fsmErrors.StoreUnavailableError(), err)
+	suite.Empty(suite.dispatchedEvents)
+}
+
+func (suite *pollingSchedulerTestSuite) TestPoll_ShouldReturnNotConfiguredError_WhenNoDispatcherIsSet() {
+	scheduler := NewPollingScheduler(suite.mockTimerStore, time.Minute)
+
+	err := scheduler.Poll(suite.ctx)
+
+	suite.Equal(fsmErrors.NotConfiguredError("dispatcher"), err)
+}
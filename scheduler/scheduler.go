@@ -0,0 +1,16 @@
+package scheduler
+
+import (
+	"context"
+
+	"github.com/Novato-Now/novato-fsm/model"
+	novato_errors "github.com/Novato-Now/novato-utils/errors"
+)
+
+//go:generate mockgen -destination=../mocks/mock_scheduler.go -package=mocks -source=scheduler.go
+
+type Scheduler interface {
+	SetDispatcher(dispatcher func(ctx context.Context, event model.ScheduledEvent))
+	Schedule(ctx context.Context, event model.ScheduledEvent) *novato_errors.Error
+	Cancel(ctx context.Context, jID string) *novato_errors.Error
+}
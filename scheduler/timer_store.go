@@ -0,0 +1,17 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/Novato-Now/novato-fsm/model"
+)
+
+//go:generate mockgen -destination=../mocks/mock_timer_store.go -package=mocks -source=timer_store.go
+
+type TimerStore interface {
+	Add(ctx context.Context, event model.ScheduledEvent) error
+	Due(ctx context.Context, now time.Time) ([]model.ScheduledEvent, error)
+	Remove(ctx context.Context, id string) error
+	RemoveForJourney(ctx context.Context, jID string) error
+}
@@ -0,0 +1,304 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Novato-Now/novato-fsm/audit"
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	journeystore "github.com/Novato-Now/novato-fsm/journey_store"
+	"github.com/Novato-Now/novato-fsm/model"
+	nuErrors "github.com/Novato-Now/novato-utils/errors"
+	"github.com/Novato-Now/novato-utils/logging"
+)
+
+//go:generate mockgen -destination=../mocks/mock_admin_service.go -package=mocks -source=admin_service.go
+
+type AdminService[T any] interface {
+	GetJourney(ctx context.Context, jID string, actor model.AdminActor) (journey model.Journey[T], err *nuErrors.Error)
+	ForceTransition(ctx context.Context, jID string, stateName string, runRevisit bool, actor model.AdminActor) (journey model.Journey[T], err *nuErrors.Error)
+	ResetCheckpoint(ctx context.Context, jID string, stateName string, actor model.AdminActor) (journey model.Journey[T], err *nuErrors.Error)
+	PatchJourneyData(ctx context.Context, jID string, patch map[string]any, actor model.AdminActor) (journey model.Journey[T], err *nuErrors.Error)
+	DeleteJourney(ctx context.Context, jID string, actor model.AdminActor) (err *nuErrors.Error)
+}
+
+type adminService[T any] struct {
+	fs        fsmService[T]
+	auditSink audit.AuditSink
+}
+
+// NewAdminService builds an admin service over the same flow definition and options as the FsmService it repairs journeys for.
+// Every action is recorded in the audit sink before the journey is changed and is refused if it cannot be recorded.
+func NewAdminService[T any](
+	initialState model.FsmState,
+	nonInitStates []model.FsmState,
+	journeyStore journeystore.JourneyStore[T],
+	hooks model.FsmHooks[T],
+	auditSink audit.AuditSink,
+	opts ...FsmServiceOption[T],
+) (AdminService[T], *nuErrors.Error) {
+	fs := newFsmService(initialState, nonInitStates, journeyStore, hooks, opts...)
+	if err := fs.validate(); err != nil {
+		return nil, err
+	}
+	return adminService[T]{
+		fs:        fs,
+		auditSink: auditSink,
+	}, nil
+}
+
+func (as adminService[T]) GetJourney(ctx context.Context, jID string, actor model.AdminActor) (model.Journey[T], *nuErrors.Error) {
+	journey, err := as.getJourney(ctx, jID, actor)
+	if err != nil {
+		return model.Journey[T]{}, err
+	}
+	err = as.record(ctx, model.AuditEntry{JID: jID, Action: model.AuditActionGetJourney, Actor: actor, FromStage: journey.CurrentStage})
+	if err != nil {
+		return model.Journey[T]{}, err
+	}
+	return journey, nil
+}
+
+func (as adminService[T]) ForceTransition(ctx context.Context, jID string, stateName string, runRevisit bool, actor model.AdminActor) (model.Journey[T], *nuErrors.Error) {
+	log := logging.GetLogger(ctx)
+	journey, err := as.getJourney(ctx, jID, actor)
+	if err != nil {
+		return model.Journey[T]{}, err
+	}
+	fs, err := as.fs.forJourney(ctx, journey)
+	if err != nil {
+		return model.Journey[T]{}, err
+	}
+	state, err := getAdminState(ctx, fs, stateName)
+	if err != nil {
+		return model.Journey[T]{}, err
+	}
+
+	err = as.record(ctx, model.AuditEntry{
+		JID:       jID,
+		Action:    model.AuditActionForceTransition,
+		Actor:     actor,
+		FromStage: journey.CurrentStage,
+		ToStage:   state.Name,
+		Details:   map[string]any{"run_revisit": runRevisit},
+	})
+	if err != nil {
+		return model.Journey[T]{}, err
+	}
+
+	previousJourney := journey
+	if runRevisit {
+		journey, _, err = fs.handleStateRevisit(ctx, state, journey, time.Time{})
+		if err != nil {
+			return model.Journey[T]{}, err
+		}
+	} else {
+		journey.CurrentStage = state.Name
+		journey.Regions = regionsOnEnter(state, nil, false)
+		journey = markJourneyCompletion(journey, state)
+		if state.IsCheckpoint {
+			journey.LastCheckpointStage = state.Name
+		}
+	}
+	// A forced journey no longer waits for the external event of the state it was moved from.
+	journey.IsPending = false
+	log.Infof("Forcing journey %s from state %s to state %s", jID, previousJourney.CurrentStage, state.Name)
+
+	err = saveAdminJourney(ctx, fs, previousJourney, journey, true)
+	if err != nil {
+		return model.Journey[T]{}, err
+	}
+	return journey, nil
+}
+
+func (as adminService[T]) ResetCheckpoint(ctx context.Context, jID string, stateName string, actor model.AdminActor) (model.Journey[T], *nuErrors.Error) {
+	log := logging.GetLogger(ctx)
+	journey, err := as.getJourney(ctx, jID, actor)
+	if err != nil {
+		return model.Journey[T]{}, err
+	}
+	fs, err := as.fs.forJourney(ctx, journey)
+	if err != nil {
+		return model.Journey[T]{}, err
+	}
+	state, err := getAdminState(ctx, fs, stateName)
+	if err != nil {
+		return model.Journey[T]{}, err
+	}
+	if !state.IsCheckpoint {
+		log.Errorf("State %s is not a checkpoint", stateName)
+		return model.Journey[T]{}, fsmErrors.ValidationError().WithMessage("state is not a checkpoint")
+	}
+
+	err = as.record(ctx, model.AuditEntry{
+		JID:       jID,
+		Action:    model.AuditActionResetCheckpoint,
+		Actor:     actor,
+		FromStage: journey.LastCheckpointStage,
+		ToStage:   state.Name,
+	})
+	if err != nil {
+		return model.Journey[T]{}, err
+	}
+
+	previousJourney := journey
+	journey.LastCheckpointStage = state.Name
+	err = saveAdminJourney(ctx, fs, previousJourney, journey, false)
+	if err != nil {
+		return model.Journey[T]{}, err
+	}
+	return journey, nil
+}
+
+// PatchJourneyData applies patch to the JSON form of the journey data as a JSON merge patch (RFC 7386).
+func (as adminService[T]) PatchJourneyData(ctx context.Context, jID string, patch map[string]any, actor model.AdminActor) (model.Journey[T], *nuErrors.Error) {
+	log := logging.GetLogger(ctx)
+	journey, err := as.getJourney(ctx, jID, actor)
+	if err != nil {
+		return model.Journey[T]{}, err
+	}
+	fs, err := as.fs.forJourney(ctx, journey)
+	if err != nil {
+		return model.Journey[T]{}, err
+	}
+
+	previousJourney := journey
+	journey.Data, err = patchJourneyData(ctx, journey.Data, patch)
+	if err != nil {
+		return model.Journey[T]{}, err
+	}
+	err = as.record(ctx, model.AuditEntry{
+		JID:       jID,
+		Action:    model.AuditActionPatchJourneyData,
+		Actor:     actor,
+		FromStage: journey.CurrentStage,
+		Details:   patch,
+	})
+	if err != nil {
+		return model.Journey[T]{}, err
+	}
+	log.Infof("Patching data of journey %s", jID)
+
+	err = saveAdminJourney(ctx, fs, previousJourney, journey, false)
+	if err != nil {
+		return model.Journey[T]{}, err
+	}
+	return journey, nil
+}
+
+func (as adminService[T]) DeleteJourney(ctx context.Context, jID string, actor model.AdminActor) *nuErrors.Error {
+	log := logging.GetLogger(ctx)
+	journey, err := as.getJourney(ctx, jID, actor)
+	if err != nil {
+		return err
+	}
+	err = as.record(ctx, model.AuditEntry{JID: jID, Action: model.AuditActionDeleteJourney, Actor: actor, FromStage: journey.CurrentStage})
+	if err != nil {
+		return err
+	}
+	err = as.fs.journeyStore.Delete(ctx, jID)
+	if err != nil {
+		log.Errorf("Unable to delete journey. Error: %+v", err)
+		return err
+	}
+	if as.fs.scheduler != nil {
+		cancelErr := as.fs.scheduler.Cancel(ctx, jID)
+		if cancelErr != nil {
+			log.Warnf("Unable to cancel timers for deleted journey %s", jID)
+		}
+	}
+	as.fs.removeCorrelationKeys(ctx, jID)
+	return nil
+}
+
+func (as adminService[T]) getJourney(ctx context.Context, jID string, actor model.AdminActor) (model.Journey[T], *nuErrors.Error) {
+	log := logging.GetLogger(ctx)
+	if actor.ID == "" {
+		log.Error("Admin action without actor")
+		return model.Journey[T]{}, fsmErrors.ValidationError().WithMessage("actor is required")
+	}
+	journey, err := as.fs.journeyStore.Get(ctx, jID)
+	if err != nil {
+		log.Errorf("Error from journey store. Error %+v", err)
+		return model.Journey[T]{}, err
+	}
+	return journey, nil
+}
+
+func getAdminState[T any](ctx context.Context, fs fsmService[T], stateName string) (model.FsmState, *nuErrors.Error) {
+	log := logging.GetLogger(ctx)
+	state, ok := fs.states[stateName]
+	if !ok {
+		log.Errorf("Cannot find state with name %s", stateName)
+		return model.FsmState{}, fsmErrors.ValidationError().WithMessage("unknown state " + stateName)
+	}
+	return state, nil
+}
+
+func saveAdminJourney[T any](ctx context.Context, fs fsmService[T], previousJourney model.Journey[T], journey model.Journey[T], stateEntered bool) *nuErrors.Error {
+	log := logging.GetLogger(ctx)
+	err := fs.journeyStore.Save(ctx, journey)
+	if err != nil {
+		log.Errorf("Unable to save journey. Error: %+v", err)
+		return err
+	}
+	fs.onJourneySaved(ctx, previousJourney, journey, stateEntered)
+	return nil
+}
+
+func (as adminService[T]) record(ctx context.Context, entry model.AuditEntry) *nuErrors.Error {
+	log := logging.GetLogger(ctx)
+	entry.At = timeNow()
+	err := as.auditSink.Record(ctx, entry)
+	if err != nil {
+		log.Errorf("Unable to record audit entry for action %s on journey %s. Error: %+v", entry.Action, entry.JID, err)
+		return fsmErrors.StoreUnavailableError().WithMessage("audit sink is unavailable")
+	}
+	return nil
+}
+
+func patchJourneyData[T any](ctx context.Context, data T, patch map[string]any) (T, *nuErrors.Error) {
+	log := logging.GetLogger(ctx)
+	var patched T
+	original, marshalErr := json.Marshal(data)
+	if marshalErr != nil {
+		log.Errorf("Unable to marshal journey data. Error: %+v", marshalErr)
+		return patched, fsmErrors.JourneyDataError("journey data cannot be encoded")
+	}
+	var document any
+	if unmarshalErr := json.Unmarshal(original, &document); unmarshalErr != nil {
+		log.Errorf("Unable to unmarshal journey data. Error: %+v", unmarshalErr)
+		return patched, fsmErrors.JourneyDataError("journey data cannot be decoded")
+	}
+
+	merged, marshalErr := json.Marshal(mergePatch(document, patch))
+	if marshalErr != nil {
+		log.Errorf("Unable to marshal patched journey data. Error: %+v", marshalErr)
+		return patched, fsmErrors.ValidationError().WithMessage("invalid patch")
+	}
+	if unmarshalErr := json.Unmarshal(merged, &patched); unmarshalErr != nil {
+		log.Errorf("Patched journey data does not fit journey data type. Error: %+v", unmarshalErr)
+		return patched, fsmErrors.ValidationError().WithMessage("invalid patch")
+	}
+	return patched, nil
+}
+
+func mergePatch(document any, patch any) any {
+	patchObject, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+	documentObject, ok := document.(map[string]any)
+	if !ok {
+		documentObject = make(map[string]any)
+	}
+	for key, value := range patchObject {
+		if value == nil {
+			delete(documentObject, key)
+			continue
+		}
+		documentObject[key] = mergePatch(documentObject[key], value)
+	}
+	return documentObject
+}
@@ -0,0 +1,294 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/mocks"
+	"github.com/Novato-Now/novato-fsm/model"
+	"go.uber.org/mock/gomock"
+)
+
+func auditEntryWithAction(action model.AuditAction) gomock.Matcher {
+	return gomock.Cond(func(x any) bool {
+		entry, ok := x.(model.AuditEntry)
+		return ok && entry.Action == action && entry.Actor.ID == "support-1"
+	})
+}
+
+func (suite *fsmServiceTestSuite) newAdminService(auditSink *mocks.MockAuditSink) AdminService[testJourneyData] {
+	initState := model.FsmState{
+		Name:                "Init",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:                "StateA",
+			StateHandler:        suite.mockStateHandler,
+			IsCheckpoint:        true,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateB"}},
+		},
+		{
+			Name:         "StateB",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	adminService, err := NewAdminService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{}, auditSink)
+	suite.Nil(err)
+	return adminService
+}
+
+func (suite *fsmServiceTestSuite) TestAdminForceTransition_ShouldMoveJourneyAndRecordAudit() {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	mockAuditSink := mocks.NewMockAuditSink(suite.mockCtrl)
+	adminService := suite.newAdminService(mockAuditSink)
+	actor := model.AdminActor{ID: "support-1", Reason: "customer stuck"}
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateB", LastCheckpointStage: "StateA"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+
+	gomock.InOrder(
+		suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1),
+		mockAuditSink.EXPECT().Record(suite.ctx, model.AuditEntry{
+			JID:       "some-uuid",
+			Action:    model.AuditActionForceTransition,
+			Actor:     actor,
+			FromStage: "StateB",
+			ToStage:   "Init",
+			Details:   map[string]any{"run_revisit": false},
+			At:        now,
+		}).Return(nil).Times(1),
+		suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1),
+	)
+
+	updatedJourney, err := adminService.ForceTransition(suite.ctx, "some-uuid", "Init", false, actor)
+
+	suite.Equal(expectedJourney, updatedJourney)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestAdminForceTransition_ShouldRunRevisit_WhenRequested() {
+	mockAuditSink := mocks.NewMockAuditSink(suite.mockCtrl)
+	adminService := suite.newAdminService(mockAuditSink)
+	actor := model.AdminActor{ID: "support-1"}
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateB", LastCheckpointStage: "Init"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateA", LastCheckpointStage: "StateA", Data: testJourneyData{StateACompleted: true}}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Revisit(suite.ctx, "some-uuid", testJourneyData{}).Return(nil, testJourneyData{StateACompleted: true}, nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+	mockAuditSink.EXPECT().Record(suite.ctx, auditEntryWithAction(model.AuditActionForceTransition)).Return(nil).Times(1)
+
+	updatedJourney, err := adminService.ForceTransition(suite.ctx, "some-uuid", "StateA", true, actor)
+
+	suite.Equal(expectedJourney, updatedJourney)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestAdminForceTransition_ShouldReturnValidationError_WhenStateIsUnknown() {
+	mockAuditSink := mocks.NewMockAuditSink(suite.mockCtrl)
+	adminService := suite.newAdminService(mockAuditSink)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateB", LastCheckpointStage: "Init"}
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+
+	updatedJourney, err := adminService.ForceTransition(suite.ctx, "some-uuid", "Missing", false, model.AdminActor{ID: "support-1"})
+
+	suite.Equal(model.Journey[testJourneyData]{}, updatedJourney)
+	suite.Equal(fsmErrors.ValidationError().WithMessage("unknown state Missing"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestAdminGetJourney_ShouldReturnValidationError_WhenActorIsMissing() {
+	mockAuditSink := mocks.NewMockAuditSink(suite.mockCtrl)
+	adminService := suite.newAdminService(mockAuditSink)
+
+	journey, err := adminService.GetJourney(suite.ctx, "some-uuid", model.AdminActor{})
+
+	suite.Equal(model.Journey[testJourneyData]{}, journey)
+	suite.Equal(fsmErrors.ValidationError().WithMessage("actor is required"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestAdminGetJourney_ShouldReturnInternalError_WhenAuditFails() {
+	mockAuditSink := mocks.NewMockAuditSink(suite.mockCtrl)
+	adminService := suite.newAdminService(mockAuditSink)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateB", LastCheckpointStage: "Init"}
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	mockAuditSink.EXPECT().Record(suite.ctx, auditEntryWithAction(model.AuditActionGetJourney)).Return(errors.New("sink down")).Times(1)
+
+	fetchedJourney, err := adminService.GetJourney(suite.ctx, "some-uuid", model.AdminActor{ID: "support-1"})
+
+	suite.Equal(model.Journey[testJourneyData]{}, fetchedJourney)
+	suite.Equal(fsmErrors.StoreUnavailableError().WithMessage("audit sink is unavailable"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestAdminResetCheckpoint_ShouldRejectNonCheckpointState() {
+	mockAuditSink := mocks.NewMockAuditSink(suite.mockCtrl)
+	adminService := suite.newAdminService(mockAuditSink)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateB", LastCheckpointStage: "StateA"}
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+
+	_, err := adminService.ResetCheckpoint(suite.ctx, "some-uuid", "StateB", model.AdminActor{ID: "support-1"})
+
+	suite.Equal(fsmErrors.ValidationError().WithMessage("state is not a checkpoint"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestAdminResetCheckpoint_ShouldSaveCheckpoint() {
+	mockAuditSink := mocks.NewMockAuditSink(suite.mockCtrl)
+	adminService := suite.newAdminService(mockAuditSink)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateB", LastCheckpointStage: "StateA"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateB", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+	mockAuditSink.EXPECT().Record(suite.ctx, auditEntryWithAction(model.AuditActionResetCheckpoint)).Return(nil).Times(1)
+
+	updatedJourney, err := adminService.ResetCheckpoint(suite.ctx, "some-uuid", "Init", model.AdminActor{ID: "support-1"})
+
+	suite.Equal(expectedJourney, updatedJourney)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestAdminPatchJourneyData_ShouldMergePatchIntoJourneyData() {
+	mockAuditSink := mocks.NewMockAuditSink(suite.mockCtrl)
+	adminService := suite.newAdminService(mockAuditSink)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateB", Data: testJourneyData{InitStateCompleted: true}}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateB", Data: testJourneyData{InitStateCompleted: true, StateACompleted: true}}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+	mockAuditSink.EXPECT().Record(suite.ctx, auditEntryWithAction(model.AuditActionPatchJourneyData)).Return(nil).Times(1)
+
+	updatedJourney, err := adminService.PatchJourneyData(suite.ctx, "some-uuid", map[string]any{"StateACompleted": true}, model.AdminActor{ID: "support-1"})
+
+	suite.Equal(expectedJourney, updatedJourney)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestAdminPatchJourneyData_ShouldReturnValidationError_WhenPatchDoesNotFitData() {
+	mockAuditSink := mocks.NewMockAuditSink(suite.mockCtrl)
+	adminService := suite.newAdminService(mockAuditSink)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateB"}
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+
+	_, err := adminService.PatchJourneyData(suite.ctx, "some-uuid", map[string]any{"StateACompleted": "yes"}, model.AdminActor{ID: "support-1"})
+
+	suite.Equal(fsmErrors.ValidationError().WithMessage("invalid patch"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestAdminDeleteJourney_ShouldDeleteJourneyAndRecordAudit() {
+	mockAuditSink := mocks.NewMockAuditSink(suite.mockCtrl)
+	adminService := suite.newAdminService(mockAuditSink)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateB"}
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Delete(suite.ctx, "some-uuid").Return(nil).Times(1)
+	mockAuditSink.EXPECT().Record(suite.ctx, auditEntryWithAction(model.AuditActionDeleteJourney)).Return(nil).Times(1)
+
+	err := adminService.DeleteJourney(suite.ctx, "some-uuid", model.AdminActor{ID: "support-1"})
+
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestAdminForceTransition_ShouldClearPending_WhenJourneyIsWaiting() {
+	mockAuditSink := mocks.NewMockAuditSink(suite.mockCtrl)
+	adminService := suite.newAdminService(mockAuditSink)
+	actor := model.AdminActor{ID: "support-1"}
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateB", LastCheckpointStage: "StateA", IsPending: true}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+	mockAuditSink.EXPECT().Record(suite.ctx, auditEntryWithAction(model.AuditActionForceTransition)).Return(nil).Times(1)
+
+	updatedJourney, err := adminService.ForceTransition(suite.ctx, "some-uuid", "Init", false, actor)
+
+	suite.Equal(expectedJourney, updatedJourney)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestAdminForceTransition_ShouldClearPending_WhenRevisitingWaitingState() {
+	mockAuditSink := mocks.NewMockAuditSink(suite.mockCtrl)
+	adminService := suite.newAdminService(mockAuditSink)
+	actor := model.AdminActor{ID: "support-1"}
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateB", LastCheckpointStage: "StateA", IsPending: true}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateB", LastCheckpointStage: "StateA"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Revisit(suite.ctx, "some-uuid", testJourneyData{}).Return(nil, testJourneyData{}, nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+	mockAuditSink.EXPECT().Record(suite.ctx, auditEntryWithAction(model.AuditActionForceTransition)).Return(nil).Times(1)
+
+	updatedJourney, err := adminService.ForceTransition(suite.ctx, "some-uuid", "StateB", true, actor)
+
+	suite.Equal(expectedJourney, updatedJourney)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestAdminForceTransition_ShouldNotChangeJourney_WhenAuditFails() {
+	mockAuditSink := mocks.NewMockAuditSink(suite.mockCtrl)
+	adminService := suite.newAdminService(mockAuditSink)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateB", LastCheckpointStage: "Init"}
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	mockAuditSink.EXPECT().Record(suite.ctx, auditEntryWithAction(model.AuditActionForceTransition)).Return(errors.New("sink down")).Times(1)
+
+	updatedJourney, err := adminService.ForceTransition(suite.ctx, "some-uuid", "StateA", true, model.AdminActor{ID: "support-1"})
+
+	suite.Equal(model.Journey[testJourneyData]{}, updatedJourney)
+	suite.Equal(fsmErrors.StoreUnavailableError().WithMessage("audit sink is unavailable"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestAdminResetCheckpoint_ShouldNotChangeJourney_WhenAuditFails() {
+	mockAuditSink := mocks.NewMockAuditSink(suite.mockCtrl)
+	adminService := suite.newAdminService(mockAuditSink)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateB", LastCheckpointStage: "StateA"}
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	mockAuditSink.EXPECT().Record(suite.ctx, auditEntryWithAction(model.AuditActionResetCheckpoint)).Return(errors.New("sink down")).Times(1)
+
+	updatedJourney, err := adminService.ResetCheckpoint(suite.ctx, "some-uuid", "Init", model.AdminActor{ID: "support-1"})
+
+	suite.Equal(model.Journey[testJourneyData]{}, updatedJourney)
+	suite.Equal(fsmErrors.StoreUnavailableError().WithMessage("audit sink is unavailable"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestAdminPatchJourneyData_ShouldNotChangeJourney_WhenAuditFails() {
+	mockAuditSink := mocks.NewMockAuditSink(suite.mockCtrl)
+	adminService := suite.newAdminService(mockAuditSink)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateB"}
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	mockAuditSink.EXPECT().Record(suite.ctx, auditEntryWithAction(model.AuditActionPatchJourneyData)).Return(errors.New("sink down")).Times(1)
+
+	updatedJourney, err := adminService.PatchJourneyData(suite.ctx, "some-uuid", map[string]any{"StateACompleted": true}, model.AdminActor{ID: "support-1"})
+
+	suite.Equal(model.Journey[testJourneyData]{}, updatedJourney)
+	suite.Equal(fsmErrors.StoreUnavailableError().WithMessage("audit sink is unavailable"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestAdminDeleteJourney_ShouldNotDeleteJourney_WhenAuditFails() {
+	mockAuditSink := mocks.NewMockAuditSink(suite.mockCtrl)
+	adminService := suite.newAdminService(mockAuditSink)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateB"}
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	mockAuditSink.EXPECT().Record(suite.ctx, auditEntryWithAction(model.AuditActionDeleteJourney)).Return(errors.New("sink down")).Times(1)
+
+	err := adminService.DeleteJourney(suite.ctx, "some-uuid", model.AdminActor{ID: "support-1"})
+
+	suite.Equal(fsmErrors.StoreUnavailableError().WithMessage("audit sink is unavailable"), err)
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Novato-Now/novato-fsm/model"
+	"github.com/Novato-Now/novato-utils/logging"
+)
+
+type executedStep[T any] struct {
+	state    model.FsmState
+	journey  model.Journey[T]
+	response any
+}
+
+func (fs fsmService[T]) compensate(ctx context.Context, steps []executedStep[T]) []model.CompensationResult {
+	log := logging.GetLogger(ctx)
+
+	var results []model.CompensationResult
+	for i := len(steps) - 1; i >= 0; i-- {
+		step := steps[i]
+		if step.state.Compensate == nil {
+			continue
+		}
+		log.Infof("Running compensation of state %s for JID %s", step.state.Name, step.journey.JID)
+		err := step.state.Compensate(ctx, step.journey.JID, step.journey.Data)
+		if err != nil {
+			log.Errorf("Compensation of state %s failed with error: %+v", step.state.Name, err)
+		}
+		results = append(results, model.CompensationResult{StateName: step.state.Name, Err: err})
+	}
+
+	if len(results) > 0 && fs.hooks.OnCompensated != nil {
+		fs.hooks.OnCompensated(steps[0].journey.JID, results)
+	}
+	return results
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/model"
+	nuErrors "github.com/Novato-Now/novato-utils/errors"
+	"go.uber.org/mock/gomock"
+)
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldCompensateExecutedStatesInReverseOrder_WhenLaterStateInChainFails() {
+	expectedError := nuErrors.InternalSystemError(suite.ctx).WithMessage("some-error")
+	compensationError := nuErrors.InternalSystemError(suite.ctx).WithMessage("compensation-error")
+
+	var compensatedStates []string
+	var compensatedData []any
+	compensate := func(stateName string, err *nuErrors.Error) model.StateCompensation {
+		return func(ctx context.Context, jID string, journeyData any) *nuErrors.Error {
+			compensatedStates = append(compensatedStates, stateName)
+			compensatedData = append(compensatedData, journeyData)
+			return err
+		}
+	}
+
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:                "StateA",
+			StateHandler:        suite.mockStateHandler,
+			Compensate:          compensate("StateA", nil),
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "INTERNAL_Next", DestinationStateName: "StateB"}},
+		},
+		{
+			Name:                "StateB",
+			StateHandler:        suite.mockStateHandler,
+			Compensate:          compensate("StateB", compensationError),
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "INTERNAL_Next", DestinationStateName: "StateC"}},
+		},
+		{
+			Name:         "StateC",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+
+	var hookJID string
+	var hookResults []model.CompensationResult
+	hooks := model.FsmHooks[testJourneyData]{
+		OnCompensated: func(jID string, results []model.CompensationResult) {
+			hookJID = jID
+			hookResults = results
+		},
+	}
+
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, hooks)
+	suite.Nil(err)
+
+	journeyDataInit := testJourneyData{InitStateCompleted: true}
+	journeyDataA := testJourneyData{InitStateCompleted: true, StateACompleted: true}
+	journeyDataB := testJourneyData{InitStateCompleted: true, StateACompleted: true, StateBCompleted: true}
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init", Data: journeyDataInit}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	gomock.InOrder(
+		suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", journeyDataInit, nil).Return(nil, journeyDataA, "INTERNAL_Next", nil),
+		suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", journeyDataA, nil).Return(nil, journeyDataB, "INTERNAL_Next", nil),
+		suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", journeyDataB, nil).Return(nil, nil, "", expectedError),
+	)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next"})
+
+	suite.Empty(response)
+	suite.Equal(fsmErrors.HandlerFailedError("StateC", expectedError), err)
+	suite.Equal([]string{"StateB", "StateA"}, compensatedStates)
+	suite.Equal([]any{journeyDataB, journeyDataA}, compensatedData)
+	suite.Equal("some-uuid", hookJID)
+	suite.Equal(
+		[]model.CompensationResult{{StateName: "StateB", Err: compensationError}, {StateName: "StateA"}},
+		hookResults,
+	)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldCompensateAllExecutedStates_WhenSavingJourneyFails() {
+	saveError := nuErrors.InternalSystemError(suite.ctx).WithMessage("save-error")
+
+	var compensatedStates []string
+	compensate := func(stateName string) model.StateCompensation {
+		return func(ctx context.Context, jID string, journeyData any) *nuErrors.Error {
+			compensatedStates = append(compensatedStates, stateName)
+			return nil
+		}
+	}
+
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:                "StateA",
+			StateHandler:        suite.mockStateHandler,
+			Compensate:          compensate("StateA"),
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "INTERNAL_Next", DestinationStateName: "StateB"}},
+		},
+		{
+			Name:         "StateB",
+			NextScreen:   "ScreenB",
+			StateHandler: suite.mockStateHandler,
+			Compensate:   compensate("StateB"),
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateB", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	gomock.InOrder(
+		suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "INTERNAL_Next", nil),
+		suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "TransitionComplete", nil),
+	)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(saveError).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next"})
+
+	suite.Empty(response)
+	suite.Equal(saveError, err)
+	suite.Equal([]string{"StateB", "StateA"}, compensatedStates)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldNotReportCompensation_WhenNoExecutedStateDefinesIt() {
+	expectedError := nuErrors.InternalSystemError(suite.ctx).WithMessage("some-error")
+
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:                "StateA",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "INTERNAL_Next", DestinationStateName: "StateB"}},
+		},
+		{
+			Name:         "StateB",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	hooks := model.FsmHooks[testJourneyData]{
+		OnCompensated: func(jID string, results []model.CompensationResult) {
+			suite.Fail("no state defines a compensation")
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, hooks)
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	gomock.InOrder(
+		suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "INTERNAL_Next", nil),
+		suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, nil, "", expectedError),
+	)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next"})
+
+	suite.Empty(response)
+	suite.Equal(fsmErrors.HandlerFailedError("StateB", expectedError), err)
+}
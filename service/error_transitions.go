@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/model"
+	nuErrors "github.com/Novato-Now/novato-utils/errors"
+	"github.com/Novato-Now/novato-utils/logging"
+)
+
+// getErrorTransition finds the state a failed visit of state is routed to. Error transitions are matched in order;
+// one without an error code matches any error. The error state is visited with the handler error as request data.
+// routedStates are the states whose failures were already routed during this request; routing back to one of them,
+// or to state itself, is refused so a failing request cannot bounce between error states.
+func (fs fsmService[T]) getErrorTransition(ctx context.Context, state model.FsmState, err *nuErrors.Error, routedStates []string) (model.FsmState, bool) {
+	log := logging.GetLogger(ctx)
+	for _, errorTransition := range state.ErrorTransitions {
+		if errorTransition.ErrorCode != "" && !fsmErrors.HasCode(err, errorTransition.ErrorCode) {
+			continue
+		}
+		if errorTransition.DestinationStateName == state.Name || slices.Contains(routedStates, errorTransition.DestinationStateName) {
+			log.Warnf("Ignoring error transition of state %s to %s as it was already visited in this request", state.Name, errorTransition.DestinationStateName)
+			return model.FsmState{}, false
+		}
+		errorState, getErr := fs.getState(ctx, errorTransition.DestinationStateName)
+		if getErr != nil {
+			return model.FsmState{}, false
+		}
+		return errorState, true
+	}
+	return model.FsmState{}, false
+}
+
+// validateErrorTransitions rejects error transitions that form a cycle, including a state routing to itself.
+func (f flow) validateErrorTransitions() *nuErrors.Error {
+	const (
+		unvisited = iota
+		onPath
+		done
+	)
+	marks := make(map[string]int, len(f.states))
+	var path []string
+	var visit func(stateName string) *nuErrors.Error
+	visit = func(stateName string) *nuErrors.Error {
+		marks[stateName] = onPath
+		path = append(path, stateName)
+		for _, errorTransition := range f.states[stateName].ErrorTransitions {
+			destination := errorTransition.DestinationStateName
+			if _, ok := f.states[destination]; !ok {
+				continue
+			}
+			switch marks[destination] {
+			case onPath:
+				cycle := append(slices.Clone(path[slices.Index(path, destination):]), destination)
+				return fsmErrors.InvalidConfigurationError(fmt.Sprintf("error transitions form a cycle: %s", strings.Join(cycle, " -> ")))
+			case unvisited:
+				if err := visit(destination); err != nil {
+					return err
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		marks[stateName] = done
+		return nil
+	}
+
+	stateNames := make([]string, 0, len(f.states))
+	for stateName := range f.states {
+		stateNames = append(stateNames, stateName)
+	}
+	slices.Sort(stateNames)
+	for _, stateName := range stateNames {
+		if marks[stateName] != unvisited {
+			continue
+		}
+		if err := visit(stateName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
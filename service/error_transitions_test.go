@@ -0,0 +1,216 @@
+package service
+
+import (
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/model"
+	nuErrors "github.com/Novato-Now/novato-utils/errors"
+)
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldRouteToErrorState_WhenHandlerErrorCodeMatches() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Pay", DestinationStateName: "Payment"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "Payment",
+			NextScreen:   "PaymentScreen",
+			StateHandler: suite.mockStateHandler,
+			ErrorTransitions: []model.ErrorTransition{
+				{ErrorCode: "PAYMENT_DECLINED", DestinationStateName: "PaymentFailed"},
+				{DestinationStateName: "TechnicalError"},
+			},
+		},
+		{
+			Name:         "PaymentFailed",
+			NextScreen:   "PaymentFailedScreen",
+			StateHandler: suite.mockStateHandler,
+			IsCheckpoint: true,
+		},
+		{
+			Name:         "TechnicalError",
+			NextScreen:   "TechnicalErrorScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	declined := nuErrors.New("PAYMENT_DECLINED", 402)
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "PaymentFailed", LastCheckpointStage: "PaymentFailed"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, "card").Return(nil, nil, "", declined).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, declined).Return("retry-payment", testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Pay", Data: "card"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", Data: "retry-payment", NextScreen: "PaymentFailedScreen"}, response)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldRouteToCatchAllErrorState_WhenNoErrorCodeMatches() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Pay", DestinationStateName: "Payment"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "Payment",
+			NextScreen:   "PaymentScreen",
+			StateHandler: suite.mockStateHandler,
+			ErrorTransitions: []model.ErrorTransition{
+				{ErrorCode: "PAYMENT_DECLINED", DestinationStateName: "PaymentFailed"},
+				{DestinationStateName: "TechnicalError"},
+			},
+		},
+		{
+			Name:         "PaymentFailed",
+			NextScreen:   "PaymentFailedScreen",
+			StateHandler: suite.mockStateHandler,
+			IsCheckpoint: true,
+		},
+		{
+			Name:         "TechnicalError",
+			NextScreen:   "TechnicalErrorScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	timeout := nuErrors.New("GATEWAY_TIMEOUT", 504)
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "TechnicalError", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, nil, "", timeout).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, timeout).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Pay"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", NextScreen: "TechnicalErrorScreen"}, response)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnHandlerError_WhenStateHasNoErrorTransitions() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Pay", DestinationStateName: "Payment"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "Payment",
+			NextScreen:   "PaymentScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	declined := nuErrors.New("PAYMENT_DECLINED", 402)
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, nil, "", declined).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Pay"})
+
+	suite.Equal(model.FsmResponse{}, response)
+	suite.Equal(fsmErrors.HandlerFailedError("Payment", declined), err)
+}
+
+func (suite *fsmServiceTestSuite) TestNewFsmService_ShouldReturnConfigurationError_WhenErrorTransitionsFormCycle() {
+	initState := model.FsmState{
+		Name:                "Init",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Pay", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:             "StateA",
+			StateHandler:     suite.mockStateHandler,
+			ErrorTransitions: []model.ErrorTransition{{DestinationStateName: "StateB"}},
+		},
+		{
+			Name:             "StateB",
+			StateHandler:     suite.mockStateHandler,
+			ErrorTransitions: []model.ErrorTransition{{DestinationStateName: "StateA"}},
+		},
+	}
+
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+
+	suite.Nil(service)
+	suite.Equal(fsmErrors.InvalidConfigurationError("error transitions form a cycle: StateA -> StateB -> StateA"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestNewFsmService_ShouldReturnConfigurationError_WhenStateRoutesErrorsToItself() {
+	initState := model.FsmState{
+		Name:                "Init",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Pay", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:             "StateA",
+			StateHandler:     suite.mockStateHandler,
+			ErrorTransitions: []model.ErrorTransition{{DestinationStateName: "StateA"}},
+		},
+	}
+
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+
+	suite.Nil(service)
+	suite.Equal(fsmErrors.InvalidConfigurationError("error transitions form a cycle: StateA -> StateA"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldStopRouting_WhenErrorStatesRouteToEachOther() {
+	initState := model.FsmState{
+		Name:                "Init",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Pay", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:             "StateA",
+			StateHandler:     suite.mockStateHandler,
+			ErrorTransitions: []model.ErrorTransition{{DestinationStateName: "StateB"}},
+		},
+		{
+			Name:             "StateB",
+			StateHandler:     suite.mockStateHandler,
+			ErrorTransitions: []model.ErrorTransition{{DestinationStateName: "StateA"}},
+		},
+	}
+	// Bypasses constructor validation to exercise the guard for flows that reach the service unvalidated.
+	service := newFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+
+	errorA := nuErrors.New("A_FAILED", 500)
+	errorB := nuErrors.New("B_FAILED", 500)
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, nil, "", errorA).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, errorA).Return(nil, nil, "", errorB).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Pay"})
+
+	suite.Equal(model.FsmResponse{}, response)
+	suite.Equal(fsmErrors.HandlerFailedError("StateB", errorB), err)
+}
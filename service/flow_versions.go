@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/model"
+	nuErrors "github.com/Novato-Now/novato-utils/errors"
+	"github.com/Novato-Now/novato-utils/logging"
+)
+
+type flow struct {
+	states           map[string]model.FsmState
+	initialStateName string
+	stateProgress    map[string]model.Progress
+	globalEvents     []model.NextAvailableEvent
+}
+
+func newFlow(initialState model.FsmState, nonInitStates []model.FsmState, globalEvents []model.NextAvailableEvent) flow {
+	fsmStateMap := make(map[string]model.FsmState)
+	for _, state := range nonInitStates {
+		fsmStateMap[state.Name] = state
+	}
+
+	fsmStateMap[initialState.Name] = initialState
+
+	return flow{
+		states:           fsmStateMap,
+		initialStateName: initialState.Name,
+		globalEvents:     globalEvents,
+	}
+}
+
+// forJourney returns the service bound to the flow version, including its global events, the journey was started with.
+// Journeys started before versioning have no version and run against the current flow unless a flow
+// was registered for the empty version.
+func (fs fsmService[T]) forJourney(ctx context.Context, journey model.Journey[T]) (fsmService[T], *nuErrors.Error) {
+	if journey.FlowVersion == fs.flowVersion {
+		return fs, nil
+	}
+	versionedFlow, ok := fs.flowVersions[journey.FlowVersion]
+	if ok {
+		fs.flow = versionedFlow
+		return fs, nil
+	}
+	if journey.FlowVersion == "" {
+		return fs, nil
+	}
+	log := logging.GetLogger(ctx)
+	log.Errorf("Flow version %s of journey %s is not live", journey.FlowVersion, journey.JID)
+	return fsmService[T]{}, fsmErrors.UnknownFlowVersionError(journey.JID, journey.FlowVersion)
+}
@@ -0,0 +1,198 @@
+package service
+
+import (
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/model"
+)
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldPinNewJourneyToCurrentFlowVersion() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateNew"}},
+	}
+	nonInitStates := []model.FsmState{{Name: "StateNew", NextScreen: "NewScreen", StateHandler: suite.mockStateHandler}}
+	previousFlow := model.FlowDefinition{
+		Version: "v1",
+		InitialState: model.FsmState{
+			Name:                "Init",
+			NextScreen:          "InitScreen",
+			StateHandler:        suite.mockStateHandler,
+			IsCheckpoint:        true,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateOld"}},
+		},
+		NonInitStates: []model.FsmState{{Name: "StateOld", NextScreen: "OldScreen", StateHandler: suite.mockStateHandler}},
+	}
+	service, err := NewFsmService(
+		initState,
+		nonInitStates,
+		suite.mockJourneyStore,
+		model.FsmHooks[testJourneyData]{},
+		WithFlowVersion[testJourneyData]("v2"),
+		WithFlowVersions[testJourneyData](previousFlow),
+	)
+	suite.Nil(err)
+
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init", FlowVersion: "v2"}
+
+	suite.mockJourneyStore.EXPECT().Create(suite.ctx).Return(model.Journey[testJourneyData]{JID: "some-uuid"}, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{Event: "Start"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", NextScreen: "InitScreen"}, response)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldUsePinnedFlowVersion_WhenJourneyStartedOnPreviousVersion() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateNew"}},
+	}
+	nonInitStates := []model.FsmState{{Name: "StateNew", NextScreen: "NewScreen", StateHandler: suite.mockStateHandler}}
+	previousFlow := model.FlowDefinition{
+		Version: "v1",
+		InitialState: model.FsmState{
+			Name:                "Init",
+			NextScreen:          "InitScreen",
+			StateHandler:        suite.mockStateHandler,
+			IsCheckpoint:        true,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateOld"}},
+		},
+		NonInitStates: []model.FsmState{{Name: "StateOld", NextScreen: "OldScreen", StateHandler: suite.mockStateHandler}},
+	}
+	service, err := NewFsmService(
+		initState,
+		nonInitStates,
+		suite.mockJourneyStore,
+		model.FsmHooks[testJourneyData]{},
+		WithFlowVersion[testJourneyData]("v2"),
+		WithFlowVersions[testJourneyData](previousFlow),
+	)
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init", FlowVersion: "v1"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateOld", LastCheckpointStage: "Init", FlowVersion: "v1"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", NextScreen: "OldScreen"}, response)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldUseGlobalEventsOfPinnedFlowVersion() {
+	initState := model.FsmState{
+		Name:         "Init",
+		NextScreen:   "InitScreen",
+		StateHandler: suite.mockStateHandler,
+		IsCheckpoint: true,
+	}
+	nonInitStates := []model.FsmState{{Name: "CancelledNew", NextScreen: "NewScreen", StateHandler: suite.mockStateHandler}}
+	previousFlow := model.FlowDefinition{
+		Version: "v1",
+		InitialState: model.FsmState{
+			Name:         "Init",
+			NextScreen:   "InitScreen",
+			StateHandler: suite.mockStateHandler,
+			IsCheckpoint: true,
+		},
+		NonInitStates: []model.FsmState{{Name: "CancelledOld", NextScreen: "OldScreen", StateHandler: suite.mockStateHandler}},
+		GlobalEvents:  []model.NextAvailableEvent{{Event: "Cancel", DestinationStateName: "CancelledOld"}},
+	}
+	service, err := NewFsmService(
+		initState,
+		nonInitStates,
+		suite.mockJourneyStore,
+		model.FsmHooks[testJourneyData]{},
+		WithGlobalEvents[testJourneyData](model.NextAvailableEvent{Event: "Cancel", DestinationStateName: "CancelledNew"}),
+		WithFlowVersion[testJourneyData]("v2"),
+		WithFlowVersions[testJourneyData](previousFlow),
+	)
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init", FlowVersion: "v1"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "CancelledOld", LastCheckpointStage: "Init", FlowVersion: "v1"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Cancel"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", NextScreen: "OldScreen"}, response)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldUseCurrentFlow_WhenJourneyHasNoFlowVersion() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateNew"}},
+	}
+	nonInitStates := []model.FsmState{{Name: "StateNew", NextScreen: "NewScreen", StateHandler: suite.mockStateHandler}}
+	previousFlow := model.FlowDefinition{
+		Version: "v1",
+		InitialState: model.FsmState{
+			Name:                "Init",
+			NextScreen:          "InitScreen",
+			StateHandler:        suite.mockStateHandler,
+			IsCheckpoint:        true,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateOld"}},
+		},
+		NonInitStates: []model.FsmState{{Name: "StateOld", NextScreen: "OldScreen", StateHandler: suite.mockStateHandler}},
+	}
+	service, err := NewFsmService(
+		initState,
+		nonInitStates,
+		suite.mockJourneyStore,
+		model.FsmHooks[testJourneyData]{},
+		WithFlowVersion[testJourneyData]("v2"),
+		WithFlowVersions[testJourneyData](previousFlow),
+	)
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateNew", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", NextScreen: "NewScreen"}, response)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnError_WhenFlowVersionIsNotLive() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateNew"}},
+	}
+	nonInitStates := []model.FsmState{{Name: "StateNew", NextScreen: "NewScreen", StateHandler: suite.mockStateHandler}}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{}, WithFlowVersion[testJourneyData]("v2"))
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init", FlowVersion: "v0"}
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next"})
+
+	suite.Equal(model.FsmResponse{}, response)
+	suite.Equal(fsmErrors.UnknownFlowVersionError("some-uuid", "v0"), err)
+}
@@ -2,27 +2,52 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"time"
 
 	"github.com/Novato-Now/novato-fsm/constants"
+	"github.com/Novato-Now/novato-fsm/correlation"
 	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/idempotency"
 	nuErrors "github.com/Novato-Now/novato-utils/errors"
 	"github.com/Novato-Now/novato-utils/logging"
 
 	journeystore "github.com/Novato-Now/novato-fsm/journey_store"
 	"github.com/Novato-Now/novato-fsm/model"
+	"github.com/Novato-Now/novato-fsm/scheduler"
 )
 
 //go:generate mockgen -destination=../mocks/mock_fsm_service.go -package=mocks -source=fsm_service.go
 
 type FsmService[T any] interface {
 	Execute(ctx context.Context, request model.FsmRequest) (response model.FsmResponse, err *nuErrors.Error)
+	GetProgress(ctx context.Context, jID string) (progress model.Progress, err *nuErrors.Error)
+	Simulate(ctx context.Context, request model.FsmRequest) (result model.SimulationResult, err *nuErrors.Error)
+	CompletePending(ctx context.Context, request model.CompletionRequest) (response model.FsmResponse, err *nuErrors.Error)
+	Ingest(ctx context.Context, event model.ExternalEvent) (result model.IngestionResult, err *nuErrors.Error)
 }
 
 type fsmService[T any] struct {
-	states           map[string]model.FsmState
-	initialStateName string
-	journeyStore     journeystore.JourneyStore[T]
-	hooks            model.FsmHooks[T]
+	flow
+	journeyStore journeystore.JourneyStore[T]
+	hooks        model.FsmHooks[T]
+
+	eventsAfterCompletion []string
+	deleteOnCompletion    bool
+	journeyArchiver       journeystore.JourneyArchiver[T]
+	scheduler             scheduler.Scheduler
+	partialProgressPolicy model.PartialProgressPolicy
+	idempotencyStore      idempotency.IdempotencyStore
+	idempotencyWindow     time.Duration
+	includeNavigation     bool
+	includeProgress       bool
+	flowVersion           string
+	flowVersions          map[string]flow
+	executeTimeout        time.Duration
+	correlationResolver   CorrelationResolver
+	correlationStore      correlation.CorrelationStore
+	deliveryWindow        time.Duration
 }
 
 func NewFsmService[T any](
@@ -30,25 +55,68 @@ func NewFsmService[T any](
 	nonInitStates []model.FsmState,
 	journeyStore journeystore.JourneyStore[T],
 	hooks model.FsmHooks[T],
+	opts ...FsmServiceOption[T],
 ) (FsmService[T], *nuErrors.Error) {
-	fsmStateMap := make(map[string]model.FsmState)
-	for _, state := range nonInitStates {
-		fsmStateMap[state.Name] = state
+	fs := newFsmService(initialState, nonInitStates, journeyStore, hooks, opts...)
+	if err := fs.validate(); err != nil {
+		return nil, err
+	}
+	if fs.scheduler != nil {
+		fs.scheduler.SetDispatcher(fs.dispatchScheduledEvent)
 	}
 
-	fsmStateMap[initialState.Name] = initialState
+	return fs, nil
+}
 
-	return fsmService[T]{
-		states:           fsmStateMap,
-		journeyStore:     journeyStore,
-		initialStateName: initialState.Name,
-		hooks:            hooks,
-	}, nil
+func newFsmService[T any](
+	initialState model.FsmState,
+	nonInitStates []model.FsmState,
+	journeyStore journeystore.JourneyStore[T],
+	hooks model.FsmHooks[T],
+	opts ...FsmServiceOption[T],
+) fsmService[T] {
+	fs := fsmService[T]{
+		flow:         newFlow(initialState, nonInitStates, nil),
+		journeyStore: journeyStore,
+		hooks:        hooks,
+	}
+	for _, opt := range opts {
+		opt(&fs)
+	}
+	fs.stateProgress = computeStateProgress(fs.states, fs.initialStateName, fs.globalEvents)
+	for version, versionedFlow := range fs.flowVersions {
+		versionedFlow.stateProgress = computeStateProgress(versionedFlow.states, versionedFlow.initialStateName, versionedFlow.globalEvents)
+		fs.flowVersions[version] = versionedFlow
+	}
+	return fs
 }
 
-func (fs fsmService[T]) Execute(ctx context.Context, request model.FsmRequest) (response model.FsmResponse, err *nuErrors.Error) {
+func (fs fsmService[T]) validate() *nuErrors.Error {
+	if len(fs.eventsAfterCompletion) > 0 && (fs.deleteOnCompletion || fs.journeyArchiver != nil) {
+		return fsmErrors.InvalidConfigurationError("events after completion cannot be accepted when completed journeys are deleted")
+	}
+	if err := fs.flow.validateErrorTransitions(); err != nil {
+		return err
+	}
+	for _, versionedFlow := range fs.flowVersions {
+		if err := versionedFlow.validateErrorTransitions(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (fs fsmService[T]) Execute(ctx context.Context, request model.FsmRequest) (model.FsmResponse, *nuErrors.Error) {
+	if request.IdempotencyKey != "" && fs.idempotencyStore != nil {
+		return fs.executeIdempotently(ctx, request)
+	}
+	return fs.execute(ctx, request, executeOptions{})
+}
+
+func (fs fsmService[T]) execute(ctx context.Context, request model.FsmRequest, opts executeOptions) (response model.FsmResponse, err *nuErrors.Error) {
 	log := logging.GetLogger(ctx)
-	var journey model.Journey[T]
+	executeDeadline := fs.newExecuteDeadline()
+	var journey, previousJourney model.Journey[T]
 
 	var currentState, nextState, lastExecutedState model.FsmState
 	var nextStateData any
@@ -56,6 +124,30 @@ func (fs fsmService[T]) Execute(ctx context.Context, request model.FsmRequest) (
 
 	var finishStateTransition bool
 
+	var executedSteps []executedStep[T]
+	var failedState model.FsmState
+	var newJourneyJID string
+	var isChainFailure bool
+	defer func() {
+		if err == nil {
+			return
+		}
+		stepsToCompensate := executedSteps
+		var progressPersisted bool
+		if isChainFailure {
+			response, stepsToCompensate, progressPersisted = fs.persistPartialProgress(ctx, previousJourney, failedState, executedSteps)
+		}
+		fs.compensate(ctx, stepsToCompensate)
+		if newJourneyJID != "" && !progressPersisted {
+			log.Info("Rolling back journey creation")
+			deleteErr := fs.journeyStore.Delete(ctx, newJourneyJID)
+			if deleteErr != nil {
+				log.Warnf("Unable to delete journey for JID %s", newJourneyJID)
+			}
+			fs.removeCorrelationKeys(ctx, newJourneyJID)
+		}
+	}()
+
 	if request.JID != "" {
 		log.Info("Journey id found. Fetching journey from journey store.")
 		journey, err = fs.journeyStore.Get(ctx, request.JID)
@@ -63,14 +155,33 @@ func (fs fsmService[T]) Execute(ctx context.Context, request model.FsmRequest) (
 			log.Errorf("Error from journey store. Error %+v", err)
 			return
 		}
+		fs, err = fs.forJourney(ctx, journey)
+		if err != nil {
+			return
+		}
+		previousJourney = journey
+		if journey.IsCompleted() && !slices.Contains(fs.eventsAfterCompletion, request.Event) {
+			log.Errorf("Event %s is not allowed for completed journey", request.Event)
+			err = fsmErrors.ConflictError(fmt.Sprintf("journey %s is already completed", journey.JID))
+			return
+		}
+		err = fs.checkPendingEvent(ctx, journey, request.Event, opts)
+		if err != nil {
+			return
+		}
+		if request.Region != "" {
+			log.Infof("Found event for parallel region %s.", request.Region)
+			response, err = fs.handleRegionEvent(ctx, journey, request, executeDeadline)
+			return
+		}
 		if request.Event == constants.EventNameResume {
 			log.Info("Found resume event.")
-			response, err = fs.handleResumeJourney(ctx, journey)
+			response, err = fs.handleResumeJourney(ctx, journey, executeDeadline)
 			return
 		}
 		if request.Event == constants.EventNameBack {
 			log.Info("Found back event.")
-			response, err = fs.handleBackJourney(ctx, journey)
+			response, err = fs.handleBackJourney(ctx, journey, executeDeadline)
 			return
 		}
 		nextStateData = request.Data
@@ -79,51 +190,70 @@ func (fs fsmService[T]) Execute(ctx context.Context, request model.FsmRequest) (
 		log.Info("No journey id found.")
 		if request.Event != constants.EventNameStart {
 			log.Error("Invalid event name for new journey.")
-			err = fsmErrors.BypassError()
+			err = fsmErrors.InvalidStartEventError(request.Event)
 			return
 		}
 		log.Info("Journey id not found. Starting new journey.")
-		journey, nextStateData, nextEvent, err = fs.startNewJourney(ctx, request.Data)
+		var initState model.FsmState
+		initState, err = fs.getState(ctx, fs.initialStateName)
+		if err != nil {
+			return
+		}
+		response, err = validateRequestData(ctx, "", initState.RequestValidator, request.Data)
+		if err != nil {
+			return
+		}
+		journey, nextStateData, nextEvent, err = fs.startNewJourney(ctx, request.Data, executeDeadline)
 		if err != nil {
 			log.Errorf("Unable to start new journey. Error: %+v", err)
 			return
 		}
-		defer func() {
-			if err != nil {
-				log.Info("Rolling back journey creation")
-				deleteErr := fs.journeyStore.Delete(ctx, journey.JID)
-				if deleteErr != nil {
-					log.Warnf("Unable to delete journey for JID %s", journey.JID)
-				}
-			}
-		}()
+		newJourneyJID = journey.JID
 		lastExecutedState, err = fs.getState(ctx, journey.CurrentStage)
 		if err != nil {
 			log.Errorf("Unable to fetch last executed state. Error: %+v", err)
 			return
 		}
+		executedSteps = append(executedSteps, executedStep[T]{state: lastExecutedState, journey: journey, response: nextStateData})
 		if nextEvent == constants.EventNameTransitionComplete {
 			finishStateTransition = true
 		}
 	}
 
+	isRequestTransition := request.JID != ""
 	for !finishStateTransition {
 		currentState, err = fs.getState(ctx, journey.CurrentStage)
 		if err != nil {
 			log.Errorf("Unable to fetch current state. Error: %+v", err)
+			isChainFailure = true
 			return
 		}
-		nextState, err = fs.getNextState(ctx, currentState, nextEvent)
+		var transition model.NextAvailableEvent
+		transition, nextState, err = fs.getNextTransition(ctx, currentState, nextEvent)
 		if err != nil {
 			log.Errorf("Unable to fetch next state. Error: %+v", err)
+			isChainFailure = true
 			return
 		}
-		journey, nextStateData, nextEvent, err = fs.handleStateVisit(ctx, nextState, journey, nextStateData)
+		if isRequestTransition {
+			response, err = validateRequestData(ctx, journey.JID, requestValidatorFor(transition, nextState), nextStateData)
+			if err != nil {
+				return
+			}
+			isRequestTransition = false
+		}
+		failedState = nextState
+		journey, nextStateData, nextEvent, err = fs.handleStateVisit(ctx, nextState, journey, nextStateData, executeDeadline)
 		if err != nil {
 			log.Errorf("Error from state handler visit. Error: %+v", err)
+			isChainFailure = true
 			return
 		}
+		if journey.CurrentStage != nextState.Name {
+			nextState = fs.states[journey.CurrentStage]
+		}
 		lastExecutedState = nextState
+		executedSteps = append(executedSteps, executedStep[T]{state: nextState, journey: journey, response: nextStateData})
 		if nextEvent == constants.EventNameTransitionComplete {
 			finishStateTransition = true
 		}
@@ -134,9 +264,7 @@ func (fs fsmService[T]) Execute(ctx context.Context, request model.FsmRequest) (
 		log.Errorf("Unable to save journey. Error: %+v", err)
 		return
 	}
-	if fs.hooks.OnAfterSaveJourney != nil {
-		fs.hooks.OnAfterSaveJourney(journey)
-	}
+	fs.onJourneySaved(ctx, previousJourney, journey, true)
 
 	return fs.loadFsmResponse(journey, lastExecutedState, nextStateData), nil
 }
@@ -146,62 +274,156 @@ func (fs fsmService[T]) getState(ctx context.Context, stateName string) (model.F
 	state, ok := fs.states[stateName]
 	if !ok {
 		log.Errorf("Cannot find state with name %s", stateName)
-		return model.FsmState{}, nuErrors.InternalSystemError(ctx)
+		return model.FsmState{}, fsmErrors.UnknownStateError(stateName)
 	}
 	return state, nil
 }
 
 func (fs fsmService[T]) getNextState(ctx context.Context, currentState model.FsmState, event string) (model.FsmState, *nuErrors.Error) {
+	_, nextState, err := fs.getNextTransition(ctx, currentState, event)
+	return nextState, err
+}
+
+func (fs fsmService[T]) getNextTransition(ctx context.Context, currentState model.FsmState, event string) (model.NextAvailableEvent, model.FsmState, *nuErrors.Error) {
 	log := logging.GetLogger(ctx)
-	for _, nextAvailableEvent := range currentState.NextAvailableEvents {
+	transition, ok := findNextAvailableEvent(currentState.NextAvailableEvents, event)
+	if ok {
+		log.Infof("Found next state as %s", transition.DestinationStateName)
+	} else if !slices.Contains(currentState.ExcludedGlobalEvents, event) {
+		transition, ok = findNextAvailableEvent(fs.globalEvents, event)
+		if ok {
+			log.Infof("Found next state as %s from global event %s", transition.DestinationStateName, event)
+		}
+	}
+	if !ok {
+		log.Errorf("Invalid event %s for state %s", event, currentState.Name)
+		return model.NextAvailableEvent{}, model.FsmState{}, fsmErrors.InvalidEventError(currentState.Name, event)
+	}
+	nextState, err := fs.getState(ctx, transition.DestinationStateName)
+	if err != nil {
+		return model.NextAvailableEvent{}, model.FsmState{}, err
+	}
+	return transition, nextState, nil
+}
+
+func findNextAvailableEvent(nextAvailableEvents []model.NextAvailableEvent, event string) (model.NextAvailableEvent, bool) {
+	for _, nextAvailableEvent := range nextAvailableEvents {
 		if nextAvailableEvent.Event == event {
-			log.Infof("Found next state as %s", nextAvailableEvent.DestinationStateName)
-			return fs.getState(ctx, nextAvailableEvent.DestinationStateName)
+			return nextAvailableEvent, true
 		}
 	}
-	log.Errorf("Invalid event %s for state %s", event, currentState.Name)
-	return model.FsmState{}, fsmErrors.BypassError()
+	return model.NextAvailableEvent{}, false
+}
+
+func (fs fsmService[T]) handleStateVisit(ctx context.Context, state model.FsmState, journey model.Journey[T], data any, executeDeadline time.Time) (model.Journey[T], any, string, *nuErrors.Error) {
+	return fs.visitState(ctx, state, journey, data, executeDeadline, nil)
 }
 
-func (fs fsmService[T]) handleStateVisit(ctx context.Context, state model.FsmState, journey model.Journey[T], data any) (model.Journey[T], any, string, *nuErrors.Error) {
+func (fs fsmService[T]) visitState(ctx context.Context, state model.FsmState, journey model.Journey[T], data any, executeDeadline time.Time, routedStates []string) (model.Journey[T], any, string, *nuErrors.Error) {
 	log := logging.GetLogger(ctx)
-	resp, updatedJourneyData, nextEvent, err := state.StateHandler.Visit(ctx, journey.JID, journey.Data, data)
+	if state.IsJoin && !allRegionsComplete(journey.Regions) {
+		log.Errorf("Cannot enter join state %s before all parallel regions are complete", state.Name)
+		return model.Journey[T]{}, nil, "", fsmErrors.ConflictError(fmt.Sprintf("parallel regions of journey %s are not complete", journey.JID))
+	}
+	// An error state follows a state whose handler failed, so it was never entered and has no exit action to run.
+	if len(routedStates) == 0 {
+		journeyData, err := fs.runExitAction(ctx, journey.JID, journey.CurrentStage, state, journey.Data, false)
+		if err != nil {
+			return model.Journey[T]{}, nil, "", err
+		}
+		journey.Data = journeyData
+	}
+	resp, updatedJourneyData, nextEvent, err := fs.visitHandler(ctx, state, journey.JID, journey.Data, data, executeDeadline)
 	if err != nil {
 		log.Errorf("State handler visit method failed with error: %+v", err)
+		errorState, ok := fs.getErrorTransition(ctx, state, err, routedStates)
+		if !ok {
+			return model.Journey[T]{}, nil, "", handlerFailed(state.Name, err)
+		}
+		log.Infof("Routing error of state %s to state %s", state.Name, errorState.Name)
+		// The error state gets an Execute budget of its own, as the failed state may have exhausted the current one.
+		return fs.visitState(ctx, errorState, journey, err, fs.newExecuteDeadline(), append(routedStates, state.Name))
+	}
+	journey.Data, err = handlerJourneyData[T](ctx, state.Name, updatedJourneyData)
+	if err != nil {
+		return model.Journey[T]{}, nil, "", err
+	}
+	journey.Data, err = fs.runEntryAction(ctx, journey.JID, journey.CurrentStage, state, journey.Data, false)
+	if err != nil {
 		return model.Journey[T]{}, nil, "", err
 	}
-	journey.Data = updatedJourneyData.(T)
 	journey.CurrentStage = state.Name
+	journey.Regions = regionsOnEnter(state, journey.Regions, false)
+	journey = markJourneyCompletion(journey, state)
 	if state.IsCheckpoint {
 		journey.LastCheckpointStage = state.Name
 	}
+	journey.IsPending = state.IsAsync && nextEvent == constants.EventNamePending
+	if journey.IsPending {
+		log.Infof("State %s of journey %s is waiting for an external event", state.Name, journey.JID)
+		nextEvent = constants.EventNameTransitionComplete
+	}
 	return journey, resp, nextEvent, nil
 }
 
-func (fs fsmService[T]) handleStateRevisit(ctx context.Context, state model.FsmState, journey model.Journey[T]) (model.Journey[T], any, *nuErrors.Error) {
+func (fs fsmService[T]) handleStateRevisit(ctx context.Context, state model.FsmState, journey model.Journey[T], executeDeadline time.Time) (model.Journey[T], any, *nuErrors.Error) {
 	log := logging.GetLogger(ctx)
-	resp, updatedJourneyData, err := state.StateHandler.Revisit(ctx, journey.JID, journey.Data)
+	journeyData, err := fs.runExitAction(ctx, journey.JID, journey.CurrentStage, state, journey.Data, true)
+	if err != nil {
+		return model.Journey[T]{}, nil, err
+	}
+	journey.Data = journeyData
+	resp, updatedJourneyData, err := fs.revisitHandler(ctx, state, journey.JID, journey.Data, executeDeadline)
 	if err != nil {
 		log.Errorf("State handler revisit method failed with error: %+v", err)
+		return model.Journey[T]{}, nil, handlerFailed(state.Name, err)
+	}
+	journey.Data, err = handlerJourneyData[T](ctx, state.Name, updatedJourneyData)
+	if err != nil {
+		return model.Journey[T]{}, nil, err
+	}
+	journey.Data, err = fs.runEntryAction(ctx, journey.JID, journey.CurrentStage, state, journey.Data, true)
+	if err != nil {
 		return model.Journey[T]{}, nil, err
 	}
+	journey.IsPending = journey.IsPending && journey.CurrentStage == state.Name
 	journey.CurrentStage = state.Name
+	journey.Regions = regionsOnEnter(state, journey.Regions, true)
+	journey = markJourneyCompletion(journey, state)
 	if state.IsCheckpoint {
 		journey.LastCheckpointStage = state.Name
 	}
-	journey.Data = updatedJourneyData.(T)
 	return journey, resp, nil
 }
 
-func (fs fsmService[T]) handleResumeJourney(ctx context.Context, journey model.Journey[T]) (model.FsmResponse, *nuErrors.Error) {
+func handlerJourneyData[T any](ctx context.Context, stateName string, updatedJourneyData any) (T, *nuErrors.Error) {
+	journeyData, ok := updatedJourneyData.(T)
+	if !ok {
+		log := logging.GetLogger(ctx)
+		log.Errorf("State %s returned journey data of type %T", stateName, updatedJourneyData)
+		return journeyData, fsmErrors.HandlerFailedError(stateName, fsmErrors.JourneyDataTypeError(fmt.Sprintf("%T", journeyData), fmt.Sprintf("%T", updatedJourneyData)))
+	}
+	return journeyData, nil
+}
+
+// handlerFailed reports an error returned by the handler of a state. Errors raised by the FSM itself, such as timeouts,
+// are returned as they are.
+func handlerFailed(stateName string, err *nuErrors.Error) *nuErrors.Error {
+	if fsmErrors.IsFsmError(err) {
+		return err
+	}
+	return fsmErrors.HandlerFailedError(stateName, err)
+}
+
+func (fs fsmService[T]) handleResumeJourney(ctx context.Context, journey model.Journey[T], executeDeadline time.Time) (model.FsmResponse, *nuErrors.Error) {
 	state, err := fs.getState(ctx, journey.LastCheckpointStage)
 	if err != nil {
 		return model.FsmResponse{}, err
 	}
-	return fs.revisitAndSave(ctx, journey, state)
+	return fs.revisitAndSave(ctx, journey, state, executeDeadline)
 }
 
-func (fs fsmService[T]) handleBackJourney(ctx context.Context, journey model.Journey[T]) (model.FsmResponse, *nuErrors.Error) {
+func (fs fsmService[T]) handleBackJourney(ctx context.Context, journey model.Journey[T], executeDeadline time.Time) (model.FsmResponse, *nuErrors.Error) {
 	state, err := fs.getState(ctx, journey.CurrentStage)
 	if err != nil {
 		return model.FsmResponse{}, err
@@ -210,10 +432,10 @@ func (fs fsmService[T]) handleBackJourney(ctx context.Context, journey model.Jou
 	if err != nil {
 		return model.FsmResponse{}, err
 	}
-	return fs.revisitAndSave(ctx, journey, nextState)
+	return fs.revisitAndSave(ctx, journey, nextState, executeDeadline)
 }
 
-func (fs fsmService[T]) startNewJourney(ctx context.Context, data any) (model.Journey[T], any, string, *nuErrors.Error) {
+func (fs fsmService[T]) startNewJourney(ctx context.Context, data any, executeDeadline time.Time) (model.Journey[T], any, string, *nuErrors.Error) {
 	log := logging.GetLogger(ctx)
 	initState, err := fs.getState(ctx, fs.initialStateName)
 	if err != nil {
@@ -225,22 +447,25 @@ func (fs fsmService[T]) startNewJourney(ctx context.Context, data any) (model.Jo
 		return model.Journey[T]{}, nil, "", err
 	}
 	jid := journey.JID
-	journey, resp, nextEvent, err := fs.handleStateVisit(ctx, initState, journey, data)
+	journey.FlowVersion = fs.flowVersion
+	journey, resp, nextEvent, err := fs.handleStateVisit(ctx, initState, journey, data, executeDeadline)
 	if err != nil {
 		log.Info("Rolling back journey creation")
 		deleteErr := fs.journeyStore.Delete(ctx, jid)
 		if deleteErr != nil {
 			log.Warnf("Error from journey store. Error: %+v", err)
 		}
+		fs.removeCorrelationKeys(ctx, jid)
 		return model.Journey[T]{}, nil, "", err
 	}
 
 	return journey, resp, nextEvent, nil
 }
 
-func (fs fsmService[T]) revisitAndSave(ctx context.Context, journey model.Journey[T], state model.FsmState) (model.FsmResponse, *nuErrors.Error) {
+func (fs fsmService[T]) revisitAndSave(ctx context.Context, journey model.Journey[T], state model.FsmState, executeDeadline time.Time) (model.FsmResponse, *nuErrors.Error) {
 	log := logging.GetLogger(ctx)
-	journey, resp, err := fs.handleStateRevisit(ctx, state, journey)
+	previousJourney := journey
+	journey, resp, err := fs.handleStateRevisit(ctx, state, journey, executeDeadline)
 	if err != nil {
 		return model.FsmResponse{}, err
 	}
@@ -249,18 +474,25 @@ func (fs fsmService[T]) revisitAndSave(ctx context.Context, journey model.Journe
 		log.Errorf("Error from journey store. Error: %+v", err)
 		return model.FsmResponse{}, err
 	}
-	if fs.hooks.OnAfterSaveJourney != nil {
-		fs.hooks.OnAfterSaveJourney(journey)
-	}
+	fs.onJourneySaved(ctx, previousJourney, journey, false)
 
 	return fs.loadFsmResponse(journey, state, resp), nil
 }
 
 func (fs fsmService[T]) loadFsmResponse(journey model.Journey[T], state model.FsmState, response any) model.FsmResponse {
-	return model.FsmResponse{
+	fsmResponse := model.FsmResponse{
 		JID:        journey.JID,
 		Data:       response,
 		NextScreen: state.NextScreen,
 		MetaData:   state.MetaData,
+		IsPending:  journey.IsPending,
+	}
+	if fs.includeNavigation {
+		fsmResponse.Navigation = fs.loadNavigation(journey, state, "")
+	}
+	if fs.includeProgress {
+		progress := fs.progressOf(journey, state)
+		fsmResponse.Progress = &progress
 	}
+	return fsmResponse
 }
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"time"
+
+	"github.com/Novato-Now/novato-fsm/correlation"
+	"github.com/Novato-Now/novato-fsm/idempotency"
+	journeystore "github.com/Novato-Now/novato-fsm/journey_store"
+	"github.com/Novato-Now/novato-fsm/model"
+	"github.com/Novato-Now/novato-fsm/scheduler"
+)
+
+type FsmServiceOption[T any] func(*fsmService[T])
+
+// WithGlobalEvents registers transitions accepted from any state that does not define the event itself.
+func WithGlobalEvents[T any](globalEvents ...model.NextAvailableEvent) FsmServiceOption[T] {
+	return func(fs *fsmService[T]) {
+		fs.globalEvents = append(fs.globalEvents, globalEvents...)
+	}
+}
+
+// WithEventsAfterCompletion lists the events still accepted once a journey has reached a terminal state.
+func WithEventsAfterCompletion[T any](events ...string) FsmServiceOption[T] {
+	return func(fs *fsmService[T]) {
+		fs.eventsAfterCompletion = append(fs.eventsAfterCompletion, events...)
+	}
+}
+
+// WithDeleteOnCompletion deletes journeys from the journey store once they reach a terminal state. It cannot be
+// combined with WithEventsAfterCompletion.
+func WithDeleteOnCompletion[T any]() FsmServiceOption[T] {
+	return func(fs *fsmService[T]) {
+		fs.deleteOnCompletion = true
+	}
+}
+
+// WithArchiveOnCompletion hands completed journeys to the archiver before deleting them from the journey store. It
+// cannot be combined with WithEventsAfterCompletion.
+func WithArchiveOnCompletion[T any](journeyArchiver journeystore.JourneyArchiver[T]) FsmServiceOption[T] {
+	return func(fs *fsmService[T]) {
+		fs.journeyArchiver = journeyArchiver
+	}
+}
+
+// WithScheduler enables state timers, which fire their events through Execute once due.
+func WithScheduler[T any](scheduler scheduler.Scheduler) FsmServiceOption[T] {
+	return func(fs *fsmService[T]) {
+		fs.scheduler = scheduler
+	}
+}
+
+// WithPartialProgressPolicy sets how much of a failed auto-transition chain is saved, unless the failing state overrides it.
+// When progress is saved, Execute returns the response of the saved state alongside the error.
+func WithPartialProgressPolicy[T any](policy model.PartialProgressPolicy) FsmServiceOption[T] {
+	return func(fs *fsmService[T]) {
+		fs.partialProgressPolicy = policy
+	}
+}
+
+// WithIdempotency replays the stored response for requests that repeat an idempotency key within the window.
+func WithIdempotency[T any](idempotencyStore idempotency.IdempotencyStore, window time.Duration) FsmServiceOption[T] {
+	return func(fs *fsmService[T]) {
+		fs.idempotencyStore = idempotencyStore
+		fs.idempotencyWindow = window
+	}
+}
+
+// WithNavigationInResponse adds the current state name, its available events, whether Back is possible and
+// whether it is a checkpoint to every FsmResponse.
+func WithNavigationInResponse[T any]() FsmServiceOption[T] {
+	return func(fs *fsmService[T]) {
+		fs.includeNavigation = true
+	}
+}
+
+// WithProgressInResponse adds the journey's progress through the flow graph to every FsmResponse.
+func WithProgressInResponse[T any]() FsmServiceOption[T] {
+	return func(fs *fsmService[T]) {
+		fs.includeProgress = true
+	}
+}
+
+// WithFlowVersion sets the version of the flow passed to the constructor, which is stored on every new journey.
+func WithFlowVersion[T any](version string) FsmServiceOption[T] {
+	return func(fs *fsmService[T]) {
+		fs.flowVersion = version
+	}
+}
+
+// WithFlowVersions keeps earlier flow definitions live so journeys pinned to them keep executing against them.
+// Each version accepts the global events of its definition rather than those given to WithGlobalEvents.
+func WithFlowVersions[T any](definitions ...model.FlowDefinition) FsmServiceOption[T] {
+	return func(fs *fsmService[T]) {
+		if fs.flowVersions == nil {
+			fs.flowVersions = make(map[string]flow, len(definitions))
+		}
+		for _, definition := range definitions {
+			fs.flowVersions[definition.Version] = newFlow(definition.InitialState, definition.NonInitStates, definition.GlobalEvents)
+		}
+	}
+}
+
+// WithExecuteTimeout bounds the total time the state handlers of a single Execute call, including auto transitions, may take.
+func WithExecuteTimeout[T any](timeout time.Duration) FsmServiceOption[T] {
+	return func(fs *fsmService[T]) {
+		fs.executeTimeout = timeout
+	}
+}
+
+// WithCorrelationResolver lets CompletePending find the journey of a completion request that carries a correlation key instead of a jID.
+func WithCorrelationResolver[T any](resolver CorrelationResolver) FsmServiceOption[T] {
+	return func(fs *fsmService[T]) {
+		fs.correlationResolver = resolver
+	}
+}
+
+// WithCorrelationStore enables Ingest, which finds journeys by correlation key and ignores deliveries repeated within
+// the window. Without an explicit CorrelationResolver, CompletePending resolves correlation keys through the store too.
+func WithCorrelationStore[T any](correlationStore correlation.CorrelationStore, deliveryWindow time.Duration) FsmServiceOption[T] {
+	return func(fs *fsmService[T]) {
+		fs.correlationStore = correlationStore
+		fs.deliveryWindow = deliveryWindow
+	}
+}
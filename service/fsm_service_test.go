@@ -127,7 +127,7 @@ func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnError_WhenUserStartsNe
 	response, err := service.Execute(suite.ctx, model.FsmRequest{Event: "StartNew"})
 
 	suite.Empty(response)
-	suite.Equal(fsmErrors.BypassError(), err)
+	suite.Equal(fsmErrors.InvalidStartEventError("StartNew"), err)
 }
 
 func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnError_WhenUserStartsNewJourneyAndStoreCreateFails() {
@@ -247,7 +247,7 @@ func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnError_WhenUserStartsNe
 	response, err := service.Execute(suite.ctx, model.FsmRequest{Event: "Start"})
 
 	suite.Empty(response)
-	suite.Equal(expectedError, err)
+	suite.Equal(fsmErrors.HandlerFailedError("Init", expectedError), err)
 }
 
 func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnNoError_WhenUserTransitionsToStateA() {
@@ -389,7 +389,7 @@ func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnError_WhenUserTransiti
 	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "NextEvent", Data: request})
 
 	suite.Empty(response)
-	suite.Equal(fsmErrors.BypassError(), err)
+	suite.Equal(fsmErrors.InvalidEventError("Init", "NextEvent"), err)
 }
 
 func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnNoError_WhenUserTransitionsToStateBFromInit() {
@@ -512,11 +512,11 @@ func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnError_WhenUserTransiti
 	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next", Data: requestDataA})
 
 	suite.Empty(response)
-	suite.Equal(expectedError, err)
+	suite.Equal(fsmErrors.HandlerFailedError("StateB", expectedError), err)
 }
 
 func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnError_WhenUserTransitionsToStateBFromInit_DueToInvalidStateConfiguration() {
-	expectedError := nuErrors.InternalSystemError(suite.ctx)
+	expectedError := fsmErrors.UnknownStateError("StateC")
 
 	initState := model.FsmState{
 		Name:                "Init",
@@ -666,7 +666,7 @@ func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnError_WhenUserResumesJ
 	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Resume"})
 
 	suite.Empty(response)
-	suite.Equal(expectedError, err)
+	suite.Equal(fsmErrors.HandlerFailedError("StateB", expectedError), err)
 }
 
 func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnError_WhenUserResumesJourneyInStateB_AndStoreSaveFails() {
@@ -760,7 +760,7 @@ func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnError_WhenUserResumesJ
 	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Resume"})
 
 	suite.Empty(response)
-	suite.Equal(nuErrors.InternalSystemError(suite.ctx), err)
+	suite.Equal(fsmErrors.UnknownStateError("State"), err)
 }
 
 func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnNoError_WhenUserGoesBackToStateAFromStateB() {
@@ -872,7 +872,7 @@ func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnError_WhenUserGoesBack
 	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
 	suite.Nil(err)
 
-	expectedError := nuErrors.InternalSystemError(suite.ctx)
+	expectedError := fsmErrors.UnknownStateError("StateX")
 	journeyDataB := testJourneyData{InitStateCompleted: true, StateACompleted: true, StateBCompleted: true}
 	journeyB := model.Journey[testJourneyData]{
 		JID:                 "some-uuid",
@@ -925,7 +925,7 @@ func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnError_WhenUserGoesBack
 	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
 	suite.Nil(err)
 
-	expectedError := nuErrors.InternalSystemError(suite.ctx)
+	expectedError := fsmErrors.UnknownStateError("StateX")
 	journeyDataB := testJourneyData{InitStateCompleted: true, StateACompleted: true, StateBCompleted: true}
 	journeyB := model.Journey[testJourneyData]{
 		JID:                 "some-uuid",
@@ -941,3 +941,185 @@ func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnError_WhenUserGoesBack
 	suite.Empty(response)
 	suite.Equal(expectedError, err)
 }
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldUseGlobalEvent_WhenCurrentStateDoesNotDefineEvent() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:                 "StateA",
+			StateHandler:         suite.mockStateHandler,
+			NextScreen:           "ScreenA",
+			NextAvailableEvents:  []model.NextAvailableEvent{{Event: "Cancel", DestinationStateName: "StateB"}},
+			ExcludedGlobalEvents: []string{"Logout"},
+		},
+		{
+			Name:         "StateB",
+			StateHandler: suite.mockStateHandler,
+			NextScreen:   "ScreenB",
+		},
+		{
+			Name:         "Cancelled",
+			StateHandler: suite.mockStateHandler,
+			NextScreen:   "CancelledScreen",
+		},
+		{
+			Name:         "LoggedOut",
+			StateHandler: suite.mockStateHandler,
+			NextScreen:   "LoggedOutScreen",
+		},
+	}
+	service, err := NewFsmService(
+		initState,
+		nonInitStates,
+		suite.mockJourneyStore,
+		model.FsmHooks[testJourneyData]{},
+		WithGlobalEvents[testJourneyData](model.NextAvailableEvent{Event: "Cancel", DestinationStateName: "Cancelled"}),
+	)
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Cancelled", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Cancel"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", NextScreen: "CancelledScreen"}, response)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldPreferStateEvent_WhenStateOverridesGlobalEvent() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:                 "StateA",
+			StateHandler:         suite.mockStateHandler,
+			NextScreen:           "ScreenA",
+			NextAvailableEvents:  []model.NextAvailableEvent{{Event: "Cancel", DestinationStateName: "StateB"}},
+			ExcludedGlobalEvents: []string{"Logout"},
+		},
+		{
+			Name:         "StateB",
+			StateHandler: suite.mockStateHandler,
+			NextScreen:   "ScreenB",
+		},
+		{
+			Name:         "Cancelled",
+			StateHandler: suite.mockStateHandler,
+			NextScreen:   "CancelledScreen",
+		},
+		{
+			Name:         "LoggedOut",
+			StateHandler: suite.mockStateHandler,
+			NextScreen:   "LoggedOutScreen",
+		},
+	}
+	service, err := NewFsmService(
+		initState,
+		nonInitStates,
+		suite.mockJourneyStore,
+		model.FsmHooks[testJourneyData]{},
+		WithGlobalEvents[testJourneyData](model.NextAvailableEvent{Event: "Cancel", DestinationStateName: "Cancelled"}),
+	)
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateA", LastCheckpointStage: "Init"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateB", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Cancel"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", NextScreen: "ScreenB"}, response)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnError_WhenStateExcludesGlobalEvent() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:                 "StateA",
+			StateHandler:         suite.mockStateHandler,
+			NextScreen:           "ScreenA",
+			NextAvailableEvents:  []model.NextAvailableEvent{{Event: "Cancel", DestinationStateName: "StateB"}},
+			ExcludedGlobalEvents: []string{"Logout"},
+		},
+		{
+			Name:         "StateB",
+			StateHandler: suite.mockStateHandler,
+			NextScreen:   "ScreenB",
+		},
+		{
+			Name:         "Cancelled",
+			StateHandler: suite.mockStateHandler,
+			NextScreen:   "CancelledScreen",
+		},
+		{
+			Name:         "LoggedOut",
+			StateHandler: suite.mockStateHandler,
+			NextScreen:   "LoggedOutScreen",
+		},
+	}
+	service, err := NewFsmService(
+		initState,
+		nonInitStates,
+		suite.mockJourneyStore,
+		model.FsmHooks[testJourneyData]{},
+		WithGlobalEvents[testJourneyData](model.NextAvailableEvent{Event: "Logout", DestinationStateName: "LoggedOut"}),
+	)
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateA", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Logout"})
+
+	suite.Empty(response)
+	suite.Equal(fsmErrors.InvalidEventError("StateA", "Logout"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnHandlerFailedError_WhenHandlerReturnsWrongJourneyDataType() {
+	initState := model.FsmState{
+		Name:                "Init",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{{Name: "StateA", StateHandler: suite.mockStateHandler}}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, "not-journey-data", "TransitionComplete", nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next"})
+
+	suite.Equal(model.FsmResponse{}, response)
+	suite.Equal(fsmErrors.HandlerFailedError("StateA", fsmErrors.JourneyDataTypeError("service.testJourneyData", "string")), err)
+	suite.True(fsmErrors.HasCode(err, fsmErrors.CodeHandlerFailed))
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/model"
+	nuErrors "github.com/Novato-Now/novato-utils/errors"
+	"github.com/Novato-Now/novato-utils/logging"
+)
+
+// executeIdempotently claims the idempotency key before executing, so a concurrent duplicate is rejected instead of run twice.
+// The claim is released when the request fails, letting the client retry with the same key.
+func (fs fsmService[T]) executeIdempotently(ctx context.Context, request model.FsmRequest) (model.FsmResponse, *nuErrors.Error) {
+	log := logging.GetLogger(ctx)
+
+	scope, err := idempotencyScope(ctx, request)
+	if err != nil {
+		return model.FsmResponse{}, err
+	}
+	requestHash, err := hashRequest(ctx, request)
+	if err != nil {
+		return model.FsmResponse{}, err
+	}
+
+	record, storeErr := fs.idempotencyStore.Claim(ctx, scope, request.IdempotencyKey, model.IdempotencyRecord{
+		RequestHash:  requestHash,
+		ExpiresAt:    timeNow().Add(fs.idempotencyWindow),
+		IsInProgress: true,
+	})
+	if storeErr != nil {
+		log.Errorf("Error from idempotency store. Error: %+v", storeErr)
+		return model.FsmResponse{}, fsmErrors.StoreUnavailableError()
+	}
+	if record != nil {
+		if record.RequestHash != requestHash {
+			log.Errorf("Idempotency key %s reused with a different payload", request.IdempotencyKey)
+			return model.FsmResponse{}, fsmErrors.IdempotencyKeyReusedError()
+		}
+		if record.IsInProgress {
+			log.Errorf("Request with idempotency key %s is still in progress", request.IdempotencyKey)
+			return model.FsmResponse{}, fsmErrors.ConflictError(fmt.Sprintf("request with idempotency key %s is in progress", request.IdempotencyKey))
+		}
+		log.Infof("Returning stored response for idempotency key %s", request.IdempotencyKey)
+		return record.Response, nil
+	}
+
+	response, err := fs.execute(ctx, request, executeOptions{})
+	if err != nil {
+		storeErr = fs.idempotencyStore.Delete(ctx, scope, request.IdempotencyKey)
+		if storeErr != nil {
+			log.Warnf("Unable to release idempotency key %s", request.IdempotencyKey)
+		}
+		return response, err
+	}
+
+	storeErr = fs.idempotencyStore.Set(ctx, scope, request.IdempotencyKey, model.IdempotencyRecord{
+		RequestHash: requestHash,
+		Response:    response,
+		ExpiresAt:   timeNow().Add(fs.idempotencyWindow),
+	})
+	if storeErr != nil {
+		log.Warnf("Unable to store response for idempotency key %s", request.IdempotencyKey)
+	}
+	return response, nil
+}
+
+// idempotencyScope keys requests on existing journeys by jID and Start requests, which have no jID yet, by caller.
+func idempotencyScope(ctx context.Context, request model.FsmRequest) (model.IdempotencyScope, *nuErrors.Error) {
+	if request.JID == "" && request.CallerID == "" {
+		log := logging.GetLogger(ctx)
+		log.Error("Idempotent start request without caller id")
+		return model.IdempotencyScope{}, fsmErrors.ValidationError().WithMessage("caller id is required for idempotent start requests")
+	}
+	return model.IdempotencyScope{JID: request.JID, CallerID: request.CallerID}, nil
+}
+
+func hashRequest(ctx context.Context, request model.FsmRequest) (string, *nuErrors.Error) {
+	log := logging.GetLogger(ctx)
+	payload, err := json.Marshal(struct {
+		Event  string `json:"event"`
+		Data   any    `json:"data"`
+		Region string `json:"region"`
+	}{Event: request.Event, Data: request.Data, Region: request.Region})
+	if err != nil {
+		log.Errorf("Unable to hash request payload. Error: %+v", err)
+		return "", fsmErrors.ValidationError().WithMessage("request data cannot be encoded")
+	}
+	hash := sha256.Sum256(payload)
+	return hex.EncodeToString(hash[:]), nil
+}
@@ -0,0 +1,345 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/idempotency"
+	"github.com/Novato-Now/novato-fsm/mocks"
+	"github.com/Novato-Now/novato-fsm/model"
+	nuErrors "github.com/Novato-Now/novato-utils/errors"
+	"go.uber.org/mock/gomock"
+)
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldStoreResponse_WhenRequestHasNewIdempotencyKey() {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	mockIdempotencyStore := mocks.NewMockIdempotencyStore(suite.mockCtrl)
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "StateA",
+			NextScreen:   "ScreenA",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(
+		initState,
+		nonInitStates,
+		suite.mockJourneyStore,
+		model.FsmHooks[testJourneyData]{},
+		WithIdempotency[testJourneyData](mockIdempotencyStore, time.Hour),
+	)
+	suite.Nil(err)
+
+	request := model.FsmRequest{JID: "some-uuid", Event: "Next", Data: "some-data", IdempotencyKey: "some-key"}
+	requestHash, err := hashRequest(suite.ctx, request)
+	suite.Nil(err)
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateA", LastCheckpointStage: "Init"}
+	expectedResponse := model.FsmResponse{JID: "some-uuid", NextScreen: "ScreenA", Data: "some-response"}
+
+	mockIdempotencyStore.EXPECT().
+		Claim(suite.ctx, model.IdempotencyScope{JID: "some-uuid"}, "some-key", model.IdempotencyRecord{RequestHash: requestHash, ExpiresAt: now.Add(time.Hour), IsInProgress: true}).
+		Return(nil, nil).
+		Times(1)
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, "some-data").Return("some-response", testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+	mockIdempotencyStore.EXPECT().
+		Set(suite.ctx, model.IdempotencyScope{JID: "some-uuid"}, "some-key", model.IdempotencyRecord{RequestHash: requestHash, Response: expectedResponse, ExpiresAt: now.Add(time.Hour)}).
+		Return(nil).
+		Times(1)
+
+	response, err := service.Execute(suite.ctx, request)
+
+	suite.Equal(expectedResponse, response)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnStoredResponse_WhenRequestIsReplayed() {
+	mockIdempotencyStore := mocks.NewMockIdempotencyStore(suite.mockCtrl)
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "StateA",
+			NextScreen:   "ScreenA",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(
+		initState,
+		nonInitStates,
+		suite.mockJourneyStore,
+		model.FsmHooks[testJourneyData]{},
+		WithIdempotency[testJourneyData](mockIdempotencyStore, time.Hour),
+	)
+	suite.Nil(err)
+
+	request := model.FsmRequest{JID: "some-uuid", Event: "Next", Data: "some-data", IdempotencyKey: "some-key"}
+	requestHash, err := hashRequest(suite.ctx, request)
+	suite.Nil(err)
+	storedResponse := model.FsmResponse{JID: "some-uuid", NextScreen: "ScreenA", Data: "some-response"}
+
+	mockIdempotencyStore.EXPECT().
+		Claim(suite.ctx, model.IdempotencyScope{JID: "some-uuid"}, "some-key", gomock.Any()).
+		Return(&model.IdempotencyRecord{RequestHash: requestHash, Response: storedResponse, ExpiresAt: time.Now().Add(time.Hour)}, nil).
+		Times(1)
+
+	response, err := service.Execute(suite.ctx, request)
+
+	suite.Equal(storedResponse, response)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnError_WhenIdempotencyKeyIsReusedWithDifferentPayload() {
+	mockIdempotencyStore := mocks.NewMockIdempotencyStore(suite.mockCtrl)
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "StateA",
+			NextScreen:   "ScreenA",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(
+		initState,
+		nonInitStates,
+		suite.mockJourneyStore,
+		model.FsmHooks[testJourneyData]{},
+		WithIdempotency[testJourneyData](mockIdempotencyStore, time.Hour),
+	)
+	suite.Nil(err)
+
+	request := model.FsmRequest{JID: "some-uuid", Event: "Next", Data: "some-data", IdempotencyKey: "some-key"}
+
+	mockIdempotencyStore.EXPECT().
+		Claim(suite.ctx, model.IdempotencyScope{JID: "some-uuid"}, "some-key", gomock.Any()).
+		Return(&model.IdempotencyRecord{RequestHash: "other-hash", ExpiresAt: time.Now().Add(time.Hour)}, nil).
+		Times(1)
+
+	response, err := service.Execute(suite.ctx, request)
+
+	suite.Empty(response)
+	suite.Equal(fsmErrors.IdempotencyKeyReusedError(), err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnValidationError_WhenIdempotentStartHasNoCaller() {
+	mockIdempotencyStore := mocks.NewMockIdempotencyStore(suite.mockCtrl)
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "StateA",
+			NextScreen:   "ScreenA",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(
+		initState,
+		nonInitStates,
+		suite.mockJourneyStore,
+		model.FsmHooks[testJourneyData]{},
+		WithIdempotency[testJourneyData](mockIdempotencyStore, time.Hour),
+	)
+	suite.Nil(err)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{Event: "Start", IdempotencyKey: "some-key"})
+
+	suite.Empty(response)
+	suite.Equal(fsmErrors.ValidationError().WithMessage("caller id is required for idempotent start requests"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldScopeStartIdempotencyKeyByCaller() {
+	idempotencyStore := idempotency.NewInMemoryIdempotencyStore()
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "StateA",
+			NextScreen:   "ScreenA",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(
+		initState,
+		nonInitStates,
+		suite.mockJourneyStore,
+		model.FsmHooks[testJourneyData]{},
+		WithIdempotency[testJourneyData](idempotencyStore, time.Hour),
+	)
+	suite.Nil(err)
+
+	suite.mockJourneyStore.EXPECT().Create(suite.ctx).Return(model.Journey[testJourneyData]{JID: "first-uuid"}, nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Create(suite.ctx).Return(model.Journey[testJourneyData]{JID: "second-uuid"}, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, gomock.Any(), testJourneyData{}, nil).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(2)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, gomock.Any()).Return(nil).Times(2)
+
+	firstResponse, err := service.Execute(suite.ctx, model.FsmRequest{Event: "Start", IdempotencyKey: "some-key", CallerID: "caller-1"})
+	suite.Nil(err)
+	secondResponse, err := service.Execute(suite.ctx, model.FsmRequest{Event: "Start", IdempotencyKey: "some-key", CallerID: "caller-2"})
+	suite.Nil(err)
+	replayedResponse, err := service.Execute(suite.ctx, model.FsmRequest{Event: "Start", IdempotencyKey: "some-key", CallerID: "caller-1"})
+	suite.Nil(err)
+
+	suite.Equal("first-uuid", firstResponse.JID)
+	suite.Equal("second-uuid", secondResponse.JID)
+	suite.Equal(firstResponse, replayedResponse)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldRejectConcurrentDuplicate_WhenRequestIsInProgress() {
+	idempotencyStore := idempotency.NewInMemoryIdempotencyStore()
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "StateA",
+			NextScreen:   "ScreenA",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(
+		initState,
+		nonInitStates,
+		suite.mockJourneyStore,
+		model.FsmHooks[testJourneyData]{},
+		WithIdempotency[testJourneyData](idempotencyStore, time.Hour),
+	)
+	suite.Nil(err)
+
+	request := model.FsmRequest{JID: "some-uuid", Event: "Next", IdempotencyKey: "some-key"}
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	visiting := make(chan struct{})
+	release := make(chan struct{})
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).
+		DoAndReturn(func(context.Context, string, any, any) (any, any, string, *nuErrors.Error) {
+			close(visiting)
+			<-release
+			return nil, testJourneyData{}, "TransitionComplete", nil
+		}).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, gomock.Any()).Return(nil).Times(1)
+
+	firstResult := make(chan *nuErrors.Error)
+	go func() {
+		_, err := service.Execute(suite.ctx, request)
+		firstResult <- err
+	}()
+	<-visiting
+	_, err = service.Execute(suite.ctx, request)
+	close(release)
+
+	suite.Equal(fsmErrors.ConflictError("request with idempotency key some-key is in progress"), err)
+	suite.Nil(<-firstResult)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReleaseIdempotencyKey_WhenRequestFails() {
+	mockIdempotencyStore := mocks.NewMockIdempotencyStore(suite.mockCtrl)
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "StateA",
+			NextScreen:   "ScreenA",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(
+		initState,
+		nonInitStates,
+		suite.mockJourneyStore,
+		model.FsmHooks[testJourneyData]{},
+		WithIdempotency[testJourneyData](mockIdempotencyStore, time.Hour),
+	)
+	suite.Nil(err)
+
+	expectedError := nuErrors.New("SOME_ERROR", 500)
+	request := model.FsmRequest{JID: "some-uuid", Event: "Next", IdempotencyKey: "some-key"}
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+
+	mockIdempotencyStore.EXPECT().Claim(suite.ctx, model.IdempotencyScope{JID: "some-uuid"}, "some-key", gomock.Any()).Return(nil, nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, nil, "", expectedError).Times(1)
+	mockIdempotencyStore.EXPECT().Delete(suite.ctx, model.IdempotencyScope{JID: "some-uuid"}, "some-key").Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, request)
+
+	suite.Empty(response)
+	suite.Equal(fsmErrors.HandlerFailedError("StateA", expectedError), err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnStoreUnavailable_WhenIdempotencyStoreFails() {
+	mockIdempotencyStore := mocks.NewMockIdempotencyStore(suite.mockCtrl)
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "StateA",
+			NextScreen:   "ScreenA",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(
+		initState,
+		nonInitStates,
+		suite.mockJourneyStore,
+		model.FsmHooks[testJourneyData]{},
+		WithIdempotency[testJourneyData](mockIdempotencyStore, time.Hour),
+	)
+	suite.Nil(err)
+
+	mockIdempotencyStore.EXPECT().Claim(suite.ctx, model.IdempotencyScope{JID: "some-uuid"}, "some-key", gomock.Any()).Return(nil, errors.New("connection refused")).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next", IdempotencyKey: "some-key"})
+
+	suite.Equal(model.FsmResponse{}, response)
+	suite.Equal(fsmErrors.StoreUnavailableError(), err)
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/model"
+	nuErrors "github.com/Novato-Now/novato-utils/errors"
+	"github.com/Novato-Now/novato-utils/logging"
+)
+
+// Ingest delivers an event sent by an external system to the journey registered for its correlation key.
+// A delivery is claimed per correlation key before executing and released if execution fails, so a delivery ID
+// seen again within the delivery window is acknowledged without executing the event again.
+func (fs fsmService[T]) Ingest(ctx context.Context, event model.ExternalEvent) (model.IngestionResult, *nuErrors.Error) {
+	log := logging.GetLogger(ctx)
+	if fs.correlationStore == nil {
+		log.Error("Ingest called without a correlation store")
+		return model.IngestionResult{}, fsmErrors.NotConfiguredError("correlation store")
+	}
+	if event.CorrelationKey == "" {
+		log.Error("External event without correlation key")
+		return model.IngestionResult{}, fsmErrors.ValidationError().WithMessage("correlation key is required")
+	}
+	jID, err := fs.lookupCorrelationKey(ctx, event.CorrelationKey)
+	if err != nil {
+		return model.IngestionResult{}, err
+	}
+
+	if event.DeliveryID != "" {
+		claimed, storeErr := fs.correlationStore.ClaimDelivery(ctx, event.CorrelationKey, event.DeliveryID, timeNow().Add(fs.deliveryWindow))
+		if storeErr != nil {
+			log.Errorf("Error from correlation store. Error: %+v", storeErr)
+			return model.IngestionResult{}, fsmErrors.StoreUnavailableError()
+		}
+		if !claimed {
+			log.Infof("Ignoring duplicate delivery %s for journey %s", event.DeliveryID, jID)
+			return model.IngestionResult{JID: jID, IsDuplicate: true}, nil
+		}
+	}
+
+	log.Infof("Delivering external event %s to journey %s", event.Event, jID)
+	response, err := fs.execute(ctx, model.FsmRequest{JID: jID, Event: event.Event, Data: event.Data}, executeOptions{completesPending: true})
+	if err != nil {
+		if event.DeliveryID != "" {
+			storeErr := fs.correlationStore.ReleaseDelivery(ctx, event.CorrelationKey, event.DeliveryID)
+			if storeErr != nil {
+				log.Warnf("Unable to release delivery %s", event.DeliveryID)
+			}
+		}
+		return model.IngestionResult{JID: jID, Response: response}, err
+	}
+	return model.IngestionResult{JID: jID, Response: response}, nil
+}
+
+func (fs fsmService[T]) lookupCorrelationKey(ctx context.Context, correlationKey string) (string, *nuErrors.Error) {
+	log := logging.GetLogger(ctx)
+	jID, storeErr := fs.correlationStore.Lookup(ctx, correlationKey)
+	if storeErr != nil {
+		log.Errorf("Error from correlation store. Error: %+v", storeErr)
+		return "", fsmErrors.StoreUnavailableError()
+	}
+	if jID == "" {
+		log.Errorf("No journey registered for correlation key %s", correlationKey)
+		return "", fsmErrors.UnknownCorrelationKeyError(correlationKey)
+	}
+	return jID, nil
+}
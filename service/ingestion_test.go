@@ -0,0 +1,369 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Novato-Now/novato-fsm/correlation"
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/mocks"
+	"github.com/Novato-Now/novato-fsm/model"
+	nuErrors "github.com/Novato-Now/novato-utils/errors"
+	"go.uber.org/mock/gomock"
+)
+
+func (suite *fsmServiceTestSuite) TestIngest_ShouldDeliverEventToCorrelatedJourney() {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+	mockCorrelationStore := mocks.NewMockCorrelationStore(suite.mockCtrl)
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "VerifyAccount", DestinationStateName: "AccountVerification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "AccountVerification",
+			NextScreen:   "VerificationInProgressScreen",
+			StateHandler: suite.mockStateHandler,
+			IsAsync:      true,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "AccountVerified", DestinationStateName: "Verified"},
+				{Event: "Back", DestinationStateName: "Init"},
+			},
+		},
+		{
+			Name:         "Verified",
+			NextScreen:   "VerifiedScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{},
+		WithCorrelationStore[testJourneyData](mockCorrelationStore, time.Hour))
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "AccountVerification", LastCheckpointStage: "Init", IsPending: true}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Verified", LastCheckpointStage: "Init"}
+	mockCorrelationStore.EXPECT().Lookup(suite.ctx, "bank-ref-1").Return("some-uuid", nil).Times(1)
+	mockCorrelationStore.EXPECT().ClaimDelivery(suite.ctx, "bank-ref-1", "delivery-1", now.Add(time.Hour)).Return(true, nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, "webhook").Return("verified", testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	result, err := service.Ingest(suite.ctx, model.ExternalEvent{CorrelationKey: "bank-ref-1", DeliveryID: "delivery-1", Event: "AccountVerified", Data: "webhook"})
+
+	suite.Equal(model.IngestionResult{JID: "some-uuid", Response: model.FsmResponse{JID: "some-uuid", Data: "verified", NextScreen: "VerifiedScreen"}}, result)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestIngest_ShouldSkipExecution_WhenDeliveryWasAlreadyProcessed() {
+	mockCorrelationStore := mocks.NewMockCorrelationStore(suite.mockCtrl)
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "VerifyAccount", DestinationStateName: "AccountVerification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "AccountVerification",
+			NextScreen:   "VerificationInProgressScreen",
+			StateHandler: suite.mockStateHandler,
+			IsAsync:      true,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "AccountVerified", DestinationStateName: "Verified"},
+				{Event: "Back", DestinationStateName: "Init"},
+			},
+		},
+		{
+			Name:         "Verified",
+			NextScreen:   "VerifiedScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{},
+		WithCorrelationStore[testJourneyData](mockCorrelationStore, time.Hour))
+	suite.Nil(err)
+
+	mockCorrelationStore.EXPECT().Lookup(suite.ctx, "bank-ref-1").Return("some-uuid", nil).Times(1)
+	mockCorrelationStore.EXPECT().ClaimDelivery(suite.ctx, "bank-ref-1", "delivery-1", gomock.Any()).Return(false, nil).Times(1)
+
+	result, err := service.Ingest(suite.ctx, model.ExternalEvent{CorrelationKey: "bank-ref-1", DeliveryID: "delivery-1", Event: "AccountVerified"})
+
+	suite.Equal(model.IngestionResult{JID: "some-uuid", IsDuplicate: true}, result)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestIngest_ShouldReleaseDelivery_WhenExecutionFails() {
+	mockCorrelationStore := mocks.NewMockCorrelationStore(suite.mockCtrl)
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "VerifyAccount", DestinationStateName: "AccountVerification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "AccountVerification",
+			NextScreen:   "VerificationInProgressScreen",
+			StateHandler: suite.mockStateHandler,
+			IsAsync:      true,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "AccountVerified", DestinationStateName: "Verified"},
+				{Event: "Back", DestinationStateName: "Init"},
+			},
+		},
+		{
+			Name:         "Verified",
+			NextScreen:   "VerifiedScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{},
+		WithCorrelationStore[testJourneyData](mockCorrelationStore, time.Hour))
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "AccountVerification", LastCheckpointStage: "Init", IsPending: true}
+	mockCorrelationStore.EXPECT().Lookup(suite.ctx, "bank-ref-1").Return("some-uuid", nil).Times(1)
+	mockCorrelationStore.EXPECT().ClaimDelivery(suite.ctx, "bank-ref-1", "delivery-1", gomock.Any()).Return(true, nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	mockCorrelationStore.EXPECT().ReleaseDelivery(suite.ctx, "bank-ref-1", "delivery-1").Return(nil).Times(1)
+
+	result, err := service.Ingest(suite.ctx, model.ExternalEvent{CorrelationKey: "bank-ref-1", DeliveryID: "delivery-1", Event: "AccountRejected"})
+
+	suite.Equal(model.IngestionResult{JID: "some-uuid"}, result)
+	suite.Equal(fsmErrors.ConflictError("journey some-uuid is waiting for an external event"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestIngest_ShouldReturnUnknownCorrelationKey_WhenKeyIsNotRegistered() {
+	mockCorrelationStore := mocks.NewMockCorrelationStore(suite.mockCtrl)
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "VerifyAccount", DestinationStateName: "AccountVerification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "AccountVerification",
+			NextScreen:   "VerificationInProgressScreen",
+			StateHandler: suite.mockStateHandler,
+			IsAsync:      true,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "AccountVerified", DestinationStateName: "Verified"},
+				{Event: "Back", DestinationStateName: "Init"},
+			},
+		},
+		{
+			Name:         "Verified",
+			NextScreen:   "VerifiedScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{},
+		WithCorrelationStore[testJourneyData](mockCorrelationStore, time.Hour))
+	suite.Nil(err)
+
+	mockCorrelationStore.EXPECT().Lookup(suite.ctx, "bank-ref-1").Return("", nil).Times(1)
+
+	_, err = service.Ingest(suite.ctx, model.ExternalEvent{CorrelationKey: "bank-ref-1", Event: "AccountVerified"})
+
+	suite.Equal(fsmErrors.UnknownCorrelationKeyError("bank-ref-1"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestIngest_ShouldReturnStoreUnavailable_WhenCorrelationStoreFails() {
+	mockCorrelationStore := mocks.NewMockCorrelationStore(suite.mockCtrl)
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "VerifyAccount", DestinationStateName: "AccountVerification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "AccountVerification",
+			NextScreen:   "VerificationInProgressScreen",
+			StateHandler: suite.mockStateHandler,
+			IsAsync:      true,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "AccountVerified", DestinationStateName: "Verified"},
+				{Event: "Back", DestinationStateName: "Init"},
+			},
+		},
+		{
+			Name:         "Verified",
+			NextScreen:   "VerifiedScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{},
+		WithCorrelationStore[testJourneyData](mockCorrelationStore, time.Hour))
+	suite.Nil(err)
+
+	mockCorrelationStore.EXPECT().Lookup(suite.ctx, "bank-ref-1").Return("", errors.New("connection refused")).Times(1)
+
+	_, err = service.Ingest(suite.ctx, model.ExternalEvent{CorrelationKey: "bank-ref-1", Event: "AccountVerified"})
+
+	suite.Equal(fsmErrors.StoreUnavailableError(), err)
+}
+
+func (suite *fsmServiceTestSuite) TestCompletePending_ShouldResolveCorrelationKeyThroughStore_WhenNoResolverIsSet() {
+	mockCorrelationStore := mocks.NewMockCorrelationStore(suite.mockCtrl)
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "VerifyAccount", DestinationStateName: "AccountVerification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "AccountVerification",
+			NextScreen:   "VerificationInProgressScreen",
+			StateHandler: suite.mockStateHandler,
+			IsAsync:      true,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "AccountVerified", DestinationStateName: "Verified"},
+				{Event: "Back", DestinationStateName: "Init"},
+			},
+		},
+		{
+			Name:         "Verified",
+			NextScreen:   "VerifiedScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{},
+		WithCorrelationStore[testJourneyData](mockCorrelationStore, time.Hour))
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Verified", LastCheckpointStage: "Init"}
+	mockCorrelationStore.EXPECT().Lookup(suite.ctx, "bank-ref-1").Return("some-uuid", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+
+	_, err = service.CompletePending(suite.ctx, model.CompletionRequest{CorrelationKey: "bank-ref-1", Event: "AccountVerified"})
+
+	suite.Equal(fsmErrors.ConflictError("journey some-uuid is not waiting for an external event"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestIngest_ShouldRemoveCorrelationKeys_WhenJourneyCompletes() {
+	correlationStore := correlation.NewInMemoryCorrelationStore()
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "VerifyAccount", DestinationStateName: "AccountVerification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:                "AccountVerification",
+			NextScreen:          "VerificationInProgressScreen",
+			StateHandler:        suite.mockStateHandler,
+			IsAsync:             true,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "AccountVerified", DestinationStateName: "Verified"}},
+		},
+		{
+			Name:           "Verified",
+			NextScreen:     "VerifiedScreen",
+			StateHandler:   suite.mockStateHandler,
+			TerminalStatus: model.JourneyStatusSucceeded,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{},
+		WithCorrelationStore[testJourneyData](correlationStore, time.Hour))
+	suite.Nil(err)
+	suite.Nil(correlationStore.Register(suite.ctx, "bank-ref-1", "some-uuid"))
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "AccountVerification", LastCheckpointStage: "Init", IsPending: true}
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, gomock.Any()).Return(nil).Times(1)
+
+	_, err = service.Ingest(suite.ctx, model.ExternalEvent{CorrelationKey: "bank-ref-1", DeliveryID: "delivery-1", Event: "AccountVerified"})
+	suite.Nil(err)
+
+	jID, lookupErr := correlationStore.Lookup(suite.ctx, "bank-ref-1")
+	suite.Empty(jID)
+	suite.Nil(lookupErr)
+}
+
+func (suite *fsmServiceTestSuite) TestIngest_ShouldExecuteOnce_WhenSameDeliveryArrivesConcurrently() {
+	correlationStore := correlation.NewInMemoryCorrelationStore()
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "VerifyAccount", DestinationStateName: "AccountVerification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:                "AccountVerification",
+			NextScreen:          "VerificationInProgressScreen",
+			StateHandler:        suite.mockStateHandler,
+			IsAsync:             true,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "AccountVerified", DestinationStateName: "Verified"}},
+		},
+		{
+			Name:         "Verified",
+			NextScreen:   "VerifiedScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{},
+		WithCorrelationStore[testJourneyData](correlationStore, time.Hour))
+	suite.Nil(err)
+	suite.Nil(correlationStore.Register(suite.ctx, "bank-ref-1", "some-uuid"))
+
+	event := model.ExternalEvent{CorrelationKey: "bank-ref-1", DeliveryID: "delivery-1", Event: "AccountVerified"}
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "AccountVerification", LastCheckpointStage: "Init", IsPending: true}
+	visiting := make(chan struct{})
+	release := make(chan struct{})
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).
+		DoAndReturn(func(context.Context, string, any, any) (any, any, string, *nuErrors.Error) {
+			close(visiting)
+			<-release
+			return nil, testJourneyData{}, "TransitionComplete", nil
+		}).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, gomock.Any()).Return(nil).Times(1)
+
+	firstResult := make(chan *nuErrors.Error)
+	go func() {
+		_, err := service.Ingest(suite.ctx, event)
+		firstResult <- err
+	}()
+	<-visiting
+	result, err := service.Ingest(suite.ctx, event)
+	close(release)
+
+	suite.Equal(model.IngestionResult{JID: "some-uuid", IsDuplicate: true}, result)
+	suite.Nil(err)
+	suite.Nil(<-firstResult)
+}
+
+func (suite *fsmServiceTestSuite) TestIngest_ShouldReturnNotConfiguredError_WhenServiceHasNoCorrelationStore() {
+	initState := model.FsmState{
+		Name:         "Init",
+		NextScreen:   "InitScreen",
+		StateHandler: suite.mockStateHandler,
+		IsCheckpoint: true,
+	}
+	service, err := NewFsmService(initState, nil, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	result, err := service.Ingest(suite.ctx, model.ExternalEvent{CorrelationKey: "bank-ref-1", Event: "AccountVerified"})
+
+	suite.Equal(model.IngestionResult{}, result)
+	suite.Equal(fsmErrors.NotConfiguredError("correlation store"), err)
+}
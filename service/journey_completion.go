@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Novato-Now/novato-fsm/model"
+	"github.com/Novato-Now/novato-utils/logging"
+)
+
+var timeNow = time.Now
+
+// onJourneySaved runs the follow-ups of a saved journey. stateEntered reports whether the save follows a visit of the
+// current state of journey, which enters it again even if the journey was already in that state.
+func (fs fsmService[T]) onJourneySaved(ctx context.Context, previousJourney model.Journey[T], journey model.Journey[T], stateEntered bool) {
+	if fs.hooks.OnAfterSaveJourney != nil {
+		fs.hooks.OnAfterSaveJourney(journey)
+	}
+	fs.rescheduleStateTimers(ctx, previousJourney, journey, stateEntered)
+	if previousJourney.IsCompleted() || !journey.IsCompleted() {
+		return
+	}
+	fs.completeJourney(ctx, journey)
+}
+
+func (fs fsmService[T]) completeJourney(ctx context.Context, journey model.Journey[T]) {
+	log := logging.GetLogger(ctx)
+	log.Infof("Journey %s completed with status %s", journey.JID, journey.Status)
+
+	if fs.hooks.OnJourneyCompleted != nil {
+		fs.hooks.OnJourneyCompleted(journey)
+	}
+	fs.removeCorrelationKeys(ctx, journey.JID)
+
+	if fs.journeyArchiver != nil {
+		log.Infof("Archiving completed journey %s", journey.JID)
+		err := fs.journeyArchiver.Archive(ctx, journey)
+		if err != nil {
+			log.Errorf("Unable to archive completed journey. Error: %+v", err)
+			return
+		}
+	}
+	if fs.journeyArchiver != nil || fs.deleteOnCompletion {
+		log.Infof("Deleting completed journey %s", journey.JID)
+		err := fs.journeyStore.Delete(ctx, journey.JID)
+		if err != nil {
+			log.Warnf("Unable to delete completed journey for JID %s", journey.JID)
+		}
+	}
+}
+
+// removeCorrelationKeys stops routing external events to a journey that completed or no longer exists.
+func (fs fsmService[T]) removeCorrelationKeys(ctx context.Context, jID string) {
+	if fs.correlationStore == nil {
+		return
+	}
+	err := fs.correlationStore.RemoveJourney(ctx, jID)
+	if err != nil {
+		logging.GetLogger(ctx).Warnf("Unable to remove correlation keys of journey %s. Error: %+v", jID, err)
+	}
+}
+
+// markJourneyCompletion records the completion of a journey that enters a terminal state and clears it when the
+// journey moves to a non-terminal state, so that a reopened journey is no longer reported as completed.
+func markJourneyCompletion[T any](journey model.Journey[T], state model.FsmState) model.Journey[T] {
+	if state.TerminalStatus == "" {
+		journey.Status = ""
+		journey.CompletedAt = nil
+		return journey
+	}
+	if journey.IsCompleted() {
+		return journey
+	}
+	completedAt := timeNow()
+	journey.Status = state.TerminalStatus
+	journey.CompletedAt = &completedAt
+	return journey
+}
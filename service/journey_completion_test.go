@@ -0,0 +1,258 @@
+package service
+
+import (
+	"time"
+
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/mocks"
+	"github.com/Novato-Now/novato-fsm/model"
+)
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldCompleteJourney_WhenUserReachesTerminalState() {
+	completedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return completedAt }
+	defer func() { timeNow = time.Now }()
+
+	var completedJourney model.Journey[testJourneyData]
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Done"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:           "Done",
+			NextScreen:     "DoneScreen",
+			StateHandler:   suite.mockStateHandler,
+			IsCheckpoint:   true,
+			TerminalStatus: model.JourneyStatusSucceeded,
+		},
+	}
+	service, err := NewFsmService(
+		initState,
+		nonInitStates,
+		suite.mockJourneyStore,
+		model.FsmHooks[testJourneyData]{OnJourneyCompleted: func(journey model.Journey[testJourneyData]) { completedJourney = journey }},
+		WithDeleteOnCompletion[testJourneyData](),
+	)
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	expectedJourney := model.Journey[testJourneyData]{
+		JID:                 "some-uuid",
+		CurrentStage:        "Done",
+		LastCheckpointStage: "Done",
+		Status:              model.JourneyStatusSucceeded,
+		CompletedAt:         &completedAt,
+	}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Delete(suite.ctx, "some-uuid").Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", NextScreen: "DoneScreen"}, response)
+	suite.Nil(err)
+	suite.Equal(expectedJourney, completedJourney)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldArchiveJourney_WhenUserReachesTerminalStateWithArchiver() {
+	completedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return completedAt }
+	defer func() { timeNow = time.Now }()
+
+	mockJourneyArchiver := mocks.NewMockJourneyArchiver[testJourneyData](suite.mockCtrl)
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Done"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:           "Done",
+			NextScreen:     "DoneScreen",
+			StateHandler:   suite.mockStateHandler,
+			IsCheckpoint:   true,
+			TerminalStatus: model.JourneyStatusSucceeded,
+		},
+	}
+	service, err := NewFsmService(
+		initState,
+		nonInitStates,
+		suite.mockJourneyStore,
+		model.FsmHooks[testJourneyData]{},
+		WithArchiveOnCompletion[testJourneyData](mockJourneyArchiver),
+	)
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	expectedJourney := model.Journey[testJourneyData]{
+		JID:                 "some-uuid",
+		CurrentStage:        "Done",
+		LastCheckpointStage: "Done",
+		Status:              model.JourneyStatusSucceeded,
+		CompletedAt:         &completedAt,
+	}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+	mockJourneyArchiver.EXPECT().Archive(suite.ctx, expectedJourney).Return(nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Delete(suite.ctx, "some-uuid").Return(nil).Times(1)
+
+	_, err = service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next"})
+
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnError_WhenJourneyIsCompletedAndEventIsNotAllowed() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Done"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:           "Done",
+			NextScreen:     "DoneScreen",
+			StateHandler:   suite.mockStateHandler,
+			IsCheckpoint:   true,
+			TerminalStatus: model.JourneyStatusSucceeded,
+		},
+	}
+	service, err := NewFsmService(
+		initState,
+		nonInitStates,
+		suite.mockJourneyStore,
+		model.FsmHooks[testJourneyData]{},
+		WithEventsAfterCompletion[testJourneyData]("Resume"),
+	)
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{
+		JID:                 "some-uuid",
+		CurrentStage:        "Done",
+		LastCheckpointStage: "Done",
+		Status:              model.JourneyStatusSucceeded,
+	}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Back"})
+
+	suite.Empty(response)
+	suite.Equal(fsmErrors.ConflictError("journey some-uuid is already completed"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldResumeCompletedJourney_WhenEventIsAllowedAfterCompletion() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Done"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:           "Done",
+			NextScreen:     "DoneScreen",
+			StateHandler:   suite.mockStateHandler,
+			IsCheckpoint:   true,
+			TerminalStatus: model.JourneyStatusSucceeded,
+		},
+	}
+	service, err := NewFsmService(
+		initState,
+		nonInitStates,
+		suite.mockJourneyStore,
+		model.FsmHooks[testJourneyData]{OnJourneyCompleted: func(model.Journey[testJourneyData]) { suite.Fail("journey completed twice") }},
+		WithEventsAfterCompletion[testJourneyData]("Resume"),
+	)
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{
+		JID:                 "some-uuid",
+		CurrentStage:        "Done",
+		LastCheckpointStage: "Done",
+		Status:              model.JourneyStatusSucceeded,
+	}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Revisit(suite.ctx, "some-uuid", testJourneyData{}).Return(nil, testJourneyData{}, nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, journey).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Resume"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", NextScreen: "DoneScreen"}, response)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReopenCompletedJourney_WhenItMovesToNonTerminalState() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Done"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:                "Done",
+			NextScreen:          "DoneScreen",
+			StateHandler:        suite.mockStateHandler,
+			IsCheckpoint:        true,
+			TerminalStatus:      model.JourneyStatusSucceeded,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Back", DestinationStateName: "Init"}},
+		},
+	}
+	service, err := NewFsmService(
+		initState,
+		nonInitStates,
+		suite.mockJourneyStore,
+		model.FsmHooks[testJourneyData]{},
+		WithEventsAfterCompletion[testJourneyData]("Back"),
+	)
+	suite.Nil(err)
+
+	completedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	journey := model.Journey[testJourneyData]{
+		JID:                 "some-uuid",
+		CurrentStage:        "Done",
+		LastCheckpointStage: "Done",
+		Status:              model.JourneyStatusSucceeded,
+		CompletedAt:         &completedAt,
+	}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Revisit(suite.ctx, "some-uuid", testJourneyData{}).Return(nil, testJourneyData{}, nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Back"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", NextScreen: "InitScreen"}, response)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestNewFsmService_ShouldReturnConfigurationError_WhenCompletedJourneysAreDeletedAndEventsAfterCompletionAreAllowed() {
+	initState := model.FsmState{
+		Name:         "Init",
+		StateHandler: suite.mockStateHandler,
+		IsCheckpoint: true,
+	}
+
+	service, err := NewFsmService(initState, nil, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{},
+		WithDeleteOnCompletion[testJourneyData](),
+		WithEventsAfterCompletion[testJourneyData]("Back"))
+
+	suite.Nil(service)
+	suite.Equal(fsmErrors.InvalidConfigurationError("events after completion cannot be accepted when completed journeys are deleted"), err)
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"slices"
+
+	"github.com/Novato-Now/novato-fsm/constants"
+	"github.com/Novato-Now/novato-fsm/model"
+)
+
+// loadNavigation lists the events a client may send next. A pending journey only accepts Back from a client until the
+// external system completes it, and a completed journey only accepts the events allowed after completion. Global
+// events do not apply inside a parallel region.
+func (fs fsmService[T]) loadNavigation(journey model.Journey[T], state model.FsmState, region string) *model.Navigation {
+	navigation := &model.Navigation{
+		StateName:       state.Name,
+		Region:          region,
+		AvailableEvents: []string{},
+		IsCheckpoint:    state.IsCheckpoint,
+	}
+
+	addEvent := func(event string) {
+		if journey.IsCompleted() && !slices.Contains(fs.eventsAfterCompletion, event) {
+			return
+		}
+		if event == constants.EventNameBack {
+			navigation.CanGoBack = true
+			return
+		}
+		if journey.IsPending {
+			return
+		}
+		if !slices.Contains(navigation.AvailableEvents, event) {
+			navigation.AvailableEvents = append(navigation.AvailableEvents, event)
+		}
+	}
+	for _, nextAvailableEvent := range state.NextAvailableEvents {
+		addEvent(nextAvailableEvent.Event)
+	}
+	if region != "" {
+		return navigation
+	}
+	for _, globalEvent := range fs.globalEvents {
+		if !slices.Contains(state.ExcludedGlobalEvents, globalEvent.Event) {
+			addEvent(globalEvent.Event)
+		}
+	}
+	return navigation
+}
@@ -0,0 +1,343 @@
+package service
+
+import (
+	"time"
+
+	"github.com/Novato-Now/novato-fsm/model"
+)
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnNavigation_WhenNavigationInResponseIsEnabled() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "StateA",
+			NextScreen:   "ScreenA",
+			StateHandler: suite.mockStateHandler,
+			IsCheckpoint: true,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "Back", DestinationStateName: "Init"},
+				{Event: "Submit", DestinationStateName: "StateB"},
+				{Event: "Cancel", DestinationStateName: "StateB"},
+			},
+			ExcludedGlobalEvents: []string{"Logout"},
+		},
+		{
+			Name:         "StateB",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(
+		initState,
+		nonInitStates,
+		suite.mockJourneyStore,
+		model.FsmHooks[testJourneyData]{},
+		WithGlobalEvents[testJourneyData](
+			model.NextAvailableEvent{Event: "Cancel", DestinationStateName: "StateB"},
+			model.NextAvailableEvent{Event: "Logout", DestinationStateName: "StateB"},
+			model.NextAvailableEvent{Event: "ContactSupport", DestinationStateName: "StateB"},
+		),
+		WithNavigationInResponse[testJourneyData](),
+	)
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateA", LastCheckpointStage: "StateA"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next"})
+
+	suite.Equal(
+		model.FsmResponse{
+			JID:        "some-uuid",
+			NextScreen: "ScreenA",
+			Navigation: &model.Navigation{
+				StateName:       "StateA",
+				AvailableEvents: []string{"Submit", "Cancel", "ContactSupport"},
+				CanGoBack:       true,
+				IsCheckpoint:    true,
+			},
+		},
+		response,
+	)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldNotReturnNavigation_WhenNavigationInResponseIsDisabled() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:                "StateA",
+			NextScreen:          "ScreenA",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Back", DestinationStateName: "Init"}},
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateA", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", NextScreen: "ScreenA"}, response)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnNavigationOfRevisitedState_WhenUserGoesBack() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:                "StateA",
+			NextScreen:          "ScreenA",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Back", DestinationStateName: "Init"}},
+		},
+	}
+	service, err := NewFsmService(
+		initState,
+		nonInitStates,
+		suite.mockJourneyStore,
+		model.FsmHooks[testJourneyData]{},
+		WithNavigationInResponse[testJourneyData](),
+	)
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateA", LastCheckpointStage: "Init"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Revisit(suite.ctx, "some-uuid", testJourneyData{}).Return(nil, testJourneyData{}, nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Back"})
+
+	suite.Equal(
+		model.FsmResponse{
+			JID:        "some-uuid",
+			NextScreen: "InitScreen",
+			Navigation: &model.Navigation{StateName: "Init", AvailableEvents: []string{"Next"}, IsCheckpoint: true},
+		},
+		response,
+	)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnNoAvailableEvents_WhenStateHasNoOutgoingEvents() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Done"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "Done",
+			NextScreen:   "DoneScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(
+		initState,
+		nonInitStates,
+		suite.mockJourneyStore,
+		model.FsmHooks[testJourneyData]{},
+		WithNavigationInResponse[testJourneyData](),
+	)
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Done", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next"})
+
+	suite.Equal(
+		model.FsmResponse{
+			JID:        "some-uuid",
+			NextScreen: "DoneScreen",
+			Navigation: &model.Navigation{StateName: "Done", AvailableEvents: []string{}},
+		},
+		response,
+	)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldOnlyOfferEventsAllowedAfterCompletion_WhenJourneyCompletes() {
+	completedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return completedAt }
+	defer func() { timeNow = time.Now }()
+
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Done"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:           "Done",
+			NextScreen:     "DoneScreen",
+			StateHandler:   suite.mockStateHandler,
+			IsCheckpoint:   true,
+			TerminalStatus: model.JourneyStatusSucceeded,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "Back", DestinationStateName: "Init"},
+				{Event: "Reopen", DestinationStateName: "Init"},
+				{Event: "Edit", DestinationStateName: "Init"},
+			},
+		},
+	}
+	service, err := NewFsmService(
+		initState,
+		nonInitStates,
+		suite.mockJourneyStore,
+		model.FsmHooks[testJourneyData]{},
+		WithGlobalEvents[testJourneyData](model.NextAvailableEvent{Event: "ContactSupport", DestinationStateName: "Init"}),
+		WithEventsAfterCompletion[testJourneyData]("Reopen"),
+		WithNavigationInResponse[testJourneyData](),
+	)
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	expectedJourney := model.Journey[testJourneyData]{
+		JID:                 "some-uuid",
+		CurrentStage:        "Done",
+		LastCheckpointStage: "Done",
+		Status:              model.JourneyStatusSucceeded,
+		CompletedAt:         &completedAt,
+	}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next"})
+
+	suite.Equal(
+		model.FsmResponse{
+			JID:        "some-uuid",
+			NextScreen: "DoneScreen",
+			Navigation: &model.Navigation{StateName: "Done", AvailableEvents: []string{"Reopen"}, IsCheckpoint: true},
+		},
+		response,
+	)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnRegionNavigation_WhenUserSendsEventToRegion() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Fork"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "Fork",
+			NextScreen:   "ForkScreen",
+			StateHandler: suite.mockStateHandler,
+			ParallelRegions: []model.ParallelRegion{
+				{Name: "documents", InitialStateName: "DocumentsPending", FinalStateNames: []string{"DocumentsUploaded"}},
+			},
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Join"}},
+		},
+		{
+			Name:                "DocumentsPending",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Upload", DestinationStateName: "DocumentsReview"}},
+		},
+		{
+			Name:         "DocumentsReview",
+			NextScreen:   "DocumentsReviewScreen",
+			StateHandler: suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "Back", DestinationStateName: "DocumentsPending"},
+				{Event: "Approve", DestinationStateName: "DocumentsUploaded"},
+			},
+		},
+		{
+			Name:         "DocumentsUploaded",
+			StateHandler: suite.mockStateHandler,
+		},
+		{
+			Name:         "Join",
+			StateHandler: suite.mockStateHandler,
+			IsJoin:       true,
+		},
+	}
+	service, err := NewFsmService(
+		initState,
+		nonInitStates,
+		suite.mockJourneyStore,
+		model.FsmHooks[testJourneyData]{},
+		WithGlobalEvents[testJourneyData](model.NextAvailableEvent{Event: "Cancel", DestinationStateName: "Init"}),
+		WithNavigationInResponse[testJourneyData](),
+	)
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{
+		JID:                 "some-uuid",
+		CurrentStage:        "Fork",
+		LastCheckpointStage: "Init",
+		Regions:             map[string]model.JourneyRegion{"documents": {CurrentStage: "DocumentsPending"}},
+	}
+	expectedJourney := model.Journey[testJourneyData]{
+		JID:                 "some-uuid",
+		CurrentStage:        "Fork",
+		LastCheckpointStage: "Init",
+		Regions:             map[string]model.JourneyRegion{"documents": {CurrentStage: "DocumentsReview"}},
+	}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Upload", Region: "documents"})
+
+	suite.Equal(
+		model.FsmResponse{
+			JID:        "some-uuid",
+			NextScreen: "DocumentsReviewScreen",
+			Navigation: &model.Navigation{
+				StateName:       "DocumentsReview",
+				Region:          "documents",
+				AvailableEvents: []string{"Approve"},
+				CanGoBack:       true,
+			},
+		},
+		response,
+	)
+	suite.Nil(err)
+}
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Novato-Now/novato-fsm/constants"
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/model"
+	nuErrors "github.com/Novato-Now/novato-utils/errors"
+	"github.com/Novato-Now/novato-utils/logging"
+)
+
+func (fs fsmService[T]) handleRegionEvent(ctx context.Context, journey model.Journey[T], request model.FsmRequest, executeDeadline time.Time) (model.FsmResponse, *nuErrors.Error) {
+	log := logging.GetLogger(ctx)
+
+	previousJourney := journey
+	region, ok := journey.Regions[request.Region]
+	if !ok {
+		log.Errorf("Region %s is not active for journey %s", request.Region, journey.JID)
+		return model.FsmResponse{}, fsmErrors.ConflictError(fmt.Sprintf("region %s is not active", request.Region))
+	}
+	if region.IsComplete {
+		log.Errorf("Region %s is already complete for journey %s", request.Region, journey.JID)
+		return model.FsmResponse{}, fsmErrors.ConflictError(fmt.Sprintf("region %s is already complete", request.Region))
+	}
+	forkState, err := fs.getState(ctx, journey.CurrentStage)
+	if err != nil {
+		return model.FsmResponse{}, err
+	}
+	regionDefinition, ok := findParallelRegion(forkState, request.Region)
+	if !ok {
+		log.Errorf("State %s does not define region %s", forkState.Name, request.Region)
+		return model.FsmResponse{}, fsmErrors.ConflictError(fmt.Sprintf("region %s is not defined by state %s", request.Region, forkState.Name))
+	}
+
+	currentState, err := fs.getState(ctx, region.CurrentStage)
+	if err != nil {
+		return model.FsmResponse{}, err
+	}
+
+	var lastExecutedState model.FsmState
+	var resp any
+	if request.Event == constants.EventNameBack {
+		_, lastExecutedState, err = fs.getNextRegionTransition(ctx, currentState, constants.EventNameBack)
+		if err != nil {
+			return model.FsmResponse{}, err
+		}
+		if !fs.isRegionState(regionDefinition, lastExecutedState.Name) {
+			log.Errorf("Back event of state %s leads to state %s outside region %s", currentState.Name, lastExecutedState.Name, request.Region)
+			return model.FsmResponse{}, fsmErrors.InvalidEventError(currentState.Name, constants.EventNameBack)
+		}
+		journey.Data, err = fs.runExitAction(ctx, journey.JID, currentState.Name, lastExecutedState, journey.Data, true)
+		if err != nil {
+			return model.FsmResponse{}, err
+		}
+		var updatedJourneyData any
+		resp, updatedJourneyData, err = fs.revisitHandler(ctx, lastExecutedState, journey.JID, journey.Data, executeDeadline)
+		if err != nil {
+			log.Errorf("State handler revisit method failed with error: %+v", err)
+			return model.FsmResponse{}, handlerFailed(lastExecutedState.Name, err)
+		}
+		journey.Data, err = handlerJourneyData[T](ctx, lastExecutedState.Name, updatedJourneyData)
+		if err != nil {
+			return model.FsmResponse{}, err
+		}
+		journey.Data, err = fs.runEntryAction(ctx, journey.JID, currentState.Name, lastExecutedState, journey.Data, true)
+		if err != nil {
+			return model.FsmResponse{}, err
+		}
+	} else {
+		resp = request.Data
+		nextEvent := request.Event
+		isRequestTransition := true
+		for nextEvent != constants.EventNameTransitionComplete {
+			var transition model.NextAvailableEvent
+			transition, lastExecutedState, err = fs.getNextRegionTransition(ctx, currentState, nextEvent)
+			if err != nil {
+				return model.FsmResponse{}, err
+			}
+			if !fs.isRegionState(regionDefinition, lastExecutedState.Name) {
+				log.Errorf("Event %s of state %s leads to state %s outside region %s", nextEvent, currentState.Name, lastExecutedState.Name, request.Region)
+				return model.FsmResponse{}, fsmErrors.InvalidEventError(currentState.Name, nextEvent)
+			}
+			if isRequestTransition {
+				var validationResponse model.FsmResponse
+				validationResponse, err = validateRequestData(ctx, journey.JID, requestValidatorFor(transition, lastExecutedState), resp)
+				if err != nil {
+					return validationResponse, err
+				}
+				isRequestTransition = false
+			}
+			journey.Data, err = fs.runExitAction(ctx, journey.JID, currentState.Name, lastExecutedState, journey.Data, false)
+			if err != nil {
+				return model.FsmResponse{}, err
+			}
+			var updatedJourneyData any
+			resp, updatedJourneyData, nextEvent, err = fs.visitHandler(ctx, lastExecutedState, journey.JID, journey.Data, resp, executeDeadline)
+			if err != nil {
+				log.Errorf("State handler visit method failed with error: %+v", err)
+				return model.FsmResponse{}, handlerFailed(lastExecutedState.Name, err)
+			}
+			journey.Data, err = handlerJourneyData[T](ctx, lastExecutedState.Name, updatedJourneyData)
+			if err != nil {
+				return model.FsmResponse{}, err
+			}
+			journey.Data, err = fs.runEntryAction(ctx, journey.JID, currentState.Name, lastExecutedState, journey.Data, false)
+			if err != nil {
+				return model.FsmResponse{}, err
+			}
+			currentState = lastExecutedState
+			if slices.Contains(regionDefinition.FinalStateNames, lastExecutedState.Name) {
+				log.Infof("Region %s reached final state %s", request.Region, lastExecutedState.Name)
+				break
+			}
+		}
+	}
+
+	region.CurrentStage = lastExecutedState.Name
+	region.IsComplete = slices.Contains(regionDefinition.FinalStateNames, lastExecutedState.Name)
+	journey.Regions = withRegion(journey.Regions, request.Region, region)
+
+	err = fs.journeyStore.Save(ctx, journey)
+	if err != nil {
+		log.Errorf("Unable to save journey. Error: %+v", err)
+		return model.FsmResponse{}, err
+	}
+	fs.onJourneySaved(ctx, previousJourney, journey, false)
+
+	response := fs.loadFsmResponse(journey, lastExecutedState, resp)
+	if fs.includeNavigation {
+		response.Navigation = fs.loadNavigation(journey, lastExecutedState, request.Region)
+	}
+	return response, nil
+}
+
+func (fs fsmService[T]) getNextRegionTransition(ctx context.Context, currentState model.FsmState, event string) (model.NextAvailableEvent, model.FsmState, *nuErrors.Error) {
+	log := logging.GetLogger(ctx)
+	transition, ok := findNextAvailableEvent(currentState.NextAvailableEvents, event)
+	if !ok {
+		log.Errorf("Invalid event %s for region state %s", event, currentState.Name)
+		return model.NextAvailableEvent{}, model.FsmState{}, fsmErrors.InvalidEventError(currentState.Name, event)
+	}
+	log.Infof("Found next region state as %s", transition.DestinationStateName)
+	nextState, err := fs.getState(ctx, transition.DestinationStateName)
+	if err != nil {
+		return model.NextAvailableEvent{}, model.FsmState{}, err
+	}
+	return transition, nextState, nil
+}
+
+func regionsOnEnter(state model.FsmState, regions map[string]model.JourneyRegion, isRevisit bool) map[string]model.JourneyRegion {
+	if len(state.ParallelRegions) == 0 {
+		return nil
+	}
+	if isRevisit && regions != nil {
+		return regions
+	}
+	activatedRegions := make(map[string]model.JourneyRegion, len(state.ParallelRegions))
+	for _, region := range state.ParallelRegions {
+		activatedRegions[region.Name] = model.JourneyRegion{CurrentStage: region.InitialStateName}
+	}
+	return activatedRegions
+}
+
+// allRegionsComplete reports whether a join may be entered, which needs active regions that are all complete.
+func allRegionsComplete(regions map[string]model.JourneyRegion) bool {
+	if len(regions) == 0 {
+		return false
+	}
+	for _, region := range regions {
+		if !region.IsComplete {
+			return false
+		}
+	}
+	return true
+}
+
+// isRegionState reports whether a state belongs to a region, that is whether it can be reached from the initial
+// state of the region through forward events without leaving a final state, and cannot be reached by the main flow.
+// The second condition keeps out states such as the join, which a region event could otherwise lead to.
+func (fs fsmService[T]) isRegionState(region model.ParallelRegion, stateName string) bool {
+	if fs.forwardReachableStates(fs.initialStateName, nil, true)[stateName] {
+		return false
+	}
+	return fs.forwardReachableStates(region.InitialStateName, region.FinalStateNames, false)[stateName]
+}
+
+// forwardReachableStates returns the states reachable from a state through forward events, without following the
+// events of final states and without entering parallel regions. Global events and error transitions are followed
+// when withFlowEdges is set.
+func (fs fsmService[T]) forwardReachableStates(fromStateName string, finalStateNames []string, withFlowEdges bool) map[string]bool {
+	visited := map[string]bool{fromStateName: true}
+	queue := []string{fromStateName}
+	for len(queue) > 0 {
+		state := fs.states[queue[0]]
+		queue = queue[1:]
+		if slices.Contains(finalStateNames, state.Name) {
+			continue
+		}
+		var nextStateNames []string
+		for _, nextAvailableEvent := range state.NextAvailableEvents {
+			if nextAvailableEvent.Event != constants.EventNameBack {
+				nextStateNames = append(nextStateNames, nextAvailableEvent.DestinationStateName)
+			}
+		}
+		if withFlowEdges {
+			for _, globalEvent := range fs.globalEvents {
+				if !slices.Contains(state.ExcludedGlobalEvents, globalEvent.Event) {
+					nextStateNames = append(nextStateNames, globalEvent.DestinationStateName)
+				}
+			}
+			for _, errorTransition := range state.ErrorTransitions {
+				nextStateNames = append(nextStateNames, errorTransition.DestinationStateName)
+			}
+		}
+		for _, nextStateName := range nextStateNames {
+			if !visited[nextStateName] {
+				visited[nextStateName] = true
+				queue = append(queue, nextStateName)
+			}
+		}
+	}
+	return visited
+}
+
+func findParallelRegion(state model.FsmState, name string) (model.ParallelRegion, bool) {
+	for _, region := range state.ParallelRegions {
+		if region.Name == name {
+			return region, true
+		}
+	}
+	return model.ParallelRegion{}, false
+}
+
+func withRegion(regions map[string]model.JourneyRegion, name string, region model.JourneyRegion) map[string]model.JourneyRegion {
+	updatedRegions := make(map[string]model.JourneyRegion, len(regions))
+	for regionName, existingRegion := range regions {
+		updatedRegions[regionName] = existingRegion
+	}
+	updatedRegions[name] = region
+	return updatedRegions
+}
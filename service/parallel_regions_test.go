@@ -0,0 +1,685 @@
+package service
+
+import (
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/model"
+)
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldActivateRegions_WhenUserEntersForkState() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Fork"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "Fork",
+			NextScreen:   "ForkScreen",
+			StateHandler: suite.mockStateHandler,
+			ParallelRegions: []model.ParallelRegion{
+				{Name: "documents", InitialStateName: "DocumentsPending", FinalStateNames: []string{"DocumentsUploaded"}},
+				{Name: "nominee", InitialStateName: "NomineePending", FinalStateNames: []string{"NomineeAdded"}},
+			},
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Join"}},
+		},
+		{
+			Name:                "DocumentsPending",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Upload", DestinationStateName: "DocumentsUploaded"}},
+		},
+		{
+			Name:         "DocumentsUploaded",
+			NextScreen:   "DocumentsUploadedScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+		{
+			Name:                "NomineePending",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Add", DestinationStateName: "NomineeAdded"}},
+		},
+		{
+			Name:         "NomineeAdded",
+			StateHandler: suite.mockStateHandler,
+		},
+		{
+			Name:         "Join",
+			NextScreen:   "JoinScreen",
+			StateHandler: suite.mockStateHandler,
+			IsJoin:       true,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journeyData := testJourneyData{InitStateCompleted: true}
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init", Data: journeyData}
+	expectedJourney := model.Journey[testJourneyData]{
+		JID:                 "some-uuid",
+		CurrentStage:        "Fork",
+		LastCheckpointStage: "Init",
+		Data:                journeyData,
+		Regions: map[string]model.JourneyRegion{
+			"documents": {CurrentStage: "DocumentsPending"},
+			"nominee":   {CurrentStage: "NomineePending"},
+		},
+	}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", journeyData, nil).Return(nil, journeyData, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", NextScreen: "ForkScreen"}, response)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldCompleteRegion_WhenUserSendsEventToRegion() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Fork"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "Fork",
+			NextScreen:   "ForkScreen",
+			StateHandler: suite.mockStateHandler,
+			ParallelRegions: []model.ParallelRegion{
+				{Name: "documents", InitialStateName: "DocumentsPending", FinalStateNames: []string{"DocumentsUploaded"}},
+				{Name: "nominee", InitialStateName: "NomineePending", FinalStateNames: []string{"NomineeAdded"}},
+			},
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Join"}},
+		},
+		{
+			Name:                "DocumentsPending",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Upload", DestinationStateName: "DocumentsUploaded"}},
+		},
+		{
+			Name:         "DocumentsUploaded",
+			NextScreen:   "DocumentsUploadedScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+		{
+			Name:                "NomineePending",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Add", DestinationStateName: "NomineeAdded"}},
+		},
+		{
+			Name:         "NomineeAdded",
+			StateHandler: suite.mockStateHandler,
+		},
+		{
+			Name:         "Join",
+			NextScreen:   "JoinScreen",
+			StateHandler: suite.mockStateHandler,
+			IsJoin:       true,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journeyData := testJourneyData{InitStateCompleted: true}
+	updatedJourneyData := testJourneyData{InitStateCompleted: true, StateACompleted: true}
+	request := struct{ FieldA bool }{FieldA: true}
+	journey := model.Journey[testJourneyData]{
+		JID:                 "some-uuid",
+		CurrentStage:        "Fork",
+		LastCheckpointStage: "Init",
+		Data:                journeyData,
+		Regions: map[string]model.JourneyRegion{
+			"documents": {CurrentStage: "DocumentsPending"},
+			"nominee":   {CurrentStage: "NomineePending"},
+		},
+	}
+	expectedJourney := model.Journey[testJourneyData]{
+		JID:                 "some-uuid",
+		CurrentStage:        "Fork",
+		LastCheckpointStage: "Init",
+		Data:                updatedJourneyData,
+		Regions: map[string]model.JourneyRegion{
+			"documents": {CurrentStage: "DocumentsUploaded", IsComplete: true},
+			"nominee":   {CurrentStage: "NomineePending"},
+		},
+	}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", journeyData, request).Return("uploaded", updatedJourneyData, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Upload", Data: request, Region: "documents"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", NextScreen: "DocumentsUploadedScreen", Data: "uploaded"}, response)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnError_WhenUserSendsEventToInactiveRegion() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Fork"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "Fork",
+			NextScreen:   "ForkScreen",
+			StateHandler: suite.mockStateHandler,
+			ParallelRegions: []model.ParallelRegion{
+				{Name: "documents", InitialStateName: "DocumentsPending", FinalStateNames: []string{"DocumentsUploaded"}},
+				{Name: "nominee", InitialStateName: "NomineePending", FinalStateNames: []string{"NomineeAdded"}},
+			},
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Join"}},
+		},
+		{
+			Name:                "DocumentsPending",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Upload", DestinationStateName: "DocumentsUploaded"}},
+		},
+		{
+			Name:         "DocumentsUploaded",
+			NextScreen:   "DocumentsUploadedScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+		{
+			Name:                "NomineePending",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Add", DestinationStateName: "NomineeAdded"}},
+		},
+		{
+			Name:         "NomineeAdded",
+			StateHandler: suite.mockStateHandler,
+		},
+		{
+			Name:         "Join",
+			NextScreen:   "JoinScreen",
+			StateHandler: suite.mockStateHandler,
+			IsJoin:       true,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Upload", Region: "documents"})
+
+	suite.Empty(response)
+	suite.Equal(fsmErrors.ConflictError("region documents is not active"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnError_WhenUserJoinsBeforeAllRegionsAreComplete() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Fork"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "Fork",
+			NextScreen:   "ForkScreen",
+			StateHandler: suite.mockStateHandler,
+			ParallelRegions: []model.ParallelRegion{
+				{Name: "documents", InitialStateName: "DocumentsPending", FinalStateNames: []string{"DocumentsUploaded"}},
+				{Name: "nominee", InitialStateName: "NomineePending", FinalStateNames: []string{"NomineeAdded"}},
+			},
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Join"}},
+		},
+		{
+			Name:                "DocumentsPending",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Upload", DestinationStateName: "DocumentsUploaded"}},
+		},
+		{
+			Name:         "DocumentsUploaded",
+			NextScreen:   "DocumentsUploadedScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+		{
+			Name:                "NomineePending",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Add", DestinationStateName: "NomineeAdded"}},
+		},
+		{
+			Name:         "NomineeAdded",
+			StateHandler: suite.mockStateHandler,
+		},
+		{
+			Name:         "Join",
+			NextScreen:   "JoinScreen",
+			StateHandler: suite.mockStateHandler,
+			IsJoin:       true,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{
+		JID:                 "some-uuid",
+		CurrentStage:        "Fork",
+		LastCheckpointStage: "Init",
+		Regions: map[string]model.JourneyRegion{
+			"documents": {CurrentStage: "DocumentsUploaded", IsComplete: true},
+			"nominee":   {CurrentStage: "NomineePending"},
+		},
+	}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next"})
+
+	suite.Empty(response)
+	suite.Equal(fsmErrors.ConflictError("parallel regions of journey some-uuid are not complete"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldClearRegions_WhenUserJoinsAfterAllRegionsAreComplete() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Fork"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "Fork",
+			NextScreen:   "ForkScreen",
+			StateHandler: suite.mockStateHandler,
+			ParallelRegions: []model.ParallelRegion{
+				{Name: "documents", InitialStateName: "DocumentsPending", FinalStateNames: []string{"DocumentsUploaded"}},
+				{Name: "nominee", InitialStateName: "NomineePending", FinalStateNames: []string{"NomineeAdded"}},
+			},
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Join"}},
+		},
+		{
+			Name:                "DocumentsPending",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Upload", DestinationStateName: "DocumentsUploaded"}},
+		},
+		{
+			Name:         "DocumentsUploaded",
+			NextScreen:   "DocumentsUploadedScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+		{
+			Name:                "NomineePending",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Add", DestinationStateName: "NomineeAdded"}},
+		},
+		{
+			Name:         "NomineeAdded",
+			StateHandler: suite.mockStateHandler,
+		},
+		{
+			Name:         "Join",
+			NextScreen:   "JoinScreen",
+			StateHandler: suite.mockStateHandler,
+			IsJoin:       true,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{
+		JID:                 "some-uuid",
+		CurrentStage:        "Fork",
+		LastCheckpointStage: "Init",
+		Regions: map[string]model.JourneyRegion{
+			"documents": {CurrentStage: "DocumentsUploaded", IsComplete: true},
+			"nominee":   {CurrentStage: "NomineeAdded", IsComplete: true},
+		},
+	}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Join", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", NextScreen: "JoinScreen"}, response)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnError_WhenUserJoinsWithoutActiveRegions() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Join"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "Join",
+			NextScreen:   "JoinScreen",
+			StateHandler: suite.mockStateHandler,
+			IsJoin:       true,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next"})
+
+	suite.Empty(response)
+	suite.Equal(fsmErrors.ConflictError("parallel regions of journey some-uuid are not complete"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldRevisitRegionState_WhenUserGoesBackWithinRegion() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Fork"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "Fork",
+			NextScreen:   "ForkScreen",
+			StateHandler: suite.mockStateHandler,
+			ParallelRegions: []model.ParallelRegion{
+				{Name: "documents", InitialStateName: "DocumentsPending", FinalStateNames: []string{"DocumentsUploaded"}},
+			},
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Join"}},
+		},
+		{
+			Name:         "DocumentsPending",
+			NextScreen:   "DocumentsPendingScreen",
+			StateHandler: suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "Upload", DestinationStateName: "DocumentsReview"},
+				{Event: "Back", DestinationStateName: "Init"},
+			},
+		},
+		{
+			Name:         "DocumentsReview",
+			NextScreen:   "DocumentsReviewScreen",
+			StateHandler: suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "Confirm", DestinationStateName: "DocumentsUploaded"},
+				{Event: "Back", DestinationStateName: "DocumentsPending"},
+			},
+		},
+		{
+			Name:         "DocumentsUploaded",
+			StateHandler: suite.mockStateHandler,
+		},
+		{
+			Name:         "Join",
+			NextScreen:   "JoinScreen",
+			StateHandler: suite.mockStateHandler,
+			IsJoin:       true,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{
+		JID:                 "some-uuid",
+		CurrentStage:        "Fork",
+		LastCheckpointStage: "Init",
+		Regions:             map[string]model.JourneyRegion{"documents": {CurrentStage: "DocumentsReview"}},
+	}
+	expectedJourney := model.Journey[testJourneyData]{
+		JID:                 "some-uuid",
+		CurrentStage:        "Fork",
+		LastCheckpointStage: "Init",
+		Regions:             map[string]model.JourneyRegion{"documents": {CurrentStage: "DocumentsPending"}},
+	}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Revisit(suite.ctx, "some-uuid", testJourneyData{}).Return(nil, testJourneyData{}, nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Back", Region: "documents"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", NextScreen: "DocumentsPendingScreen"}, response)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnError_WhenRegionBackLeavesRegion() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Fork"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "Fork",
+			NextScreen:   "ForkScreen",
+			StateHandler: suite.mockStateHandler,
+			ParallelRegions: []model.ParallelRegion{
+				{Name: "documents", InitialStateName: "DocumentsPending", FinalStateNames: []string{"DocumentsUploaded"}},
+			},
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Join"}},
+		},
+		{
+			Name:         "DocumentsPending",
+			NextScreen:   "DocumentsPendingScreen",
+			StateHandler: suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "Upload", DestinationStateName: "DocumentsReview"},
+				{Event: "Back", DestinationStateName: "Init"},
+			},
+		},
+		{
+			Name:         "DocumentsReview",
+			NextScreen:   "DocumentsReviewScreen",
+			StateHandler: suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "Confirm", DestinationStateName: "DocumentsUploaded"},
+				{Event: "Back", DestinationStateName: "DocumentsPending"},
+			},
+		},
+		{
+			Name:         "DocumentsUploaded",
+			StateHandler: suite.mockStateHandler,
+		},
+		{
+			Name:         "Join",
+			NextScreen:   "JoinScreen",
+			StateHandler: suite.mockStateHandler,
+			IsJoin:       true,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{
+		JID:                 "some-uuid",
+		CurrentStage:        "Fork",
+		LastCheckpointStage: "Init",
+		Regions:             map[string]model.JourneyRegion{"documents": {CurrentStage: "DocumentsPending"}},
+	}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Back", Region: "documents"})
+
+	suite.Empty(response)
+	suite.Equal(fsmErrors.InvalidEventError("DocumentsPending", "Back"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnError_WhenRegionEventLeavesRegion() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Fork"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "Fork",
+			NextScreen:   "ForkScreen",
+			StateHandler: suite.mockStateHandler,
+			ParallelRegions: []model.ParallelRegion{
+				{Name: "documents", InitialStateName: "DocumentsPending", FinalStateNames: []string{"DocumentsUploaded"}},
+			},
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Join"}},
+		},
+		{
+			Name:         "DocumentsPending",
+			StateHandler: suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "Upload", DestinationStateName: "DocumentsUploaded"},
+				{Event: "Skip", DestinationStateName: "Join"},
+			},
+		},
+		{
+			Name:         "DocumentsUploaded",
+			StateHandler: suite.mockStateHandler,
+		},
+		{
+			Name:         "Join",
+			NextScreen:   "JoinScreen",
+			StateHandler: suite.mockStateHandler,
+			IsJoin:       true,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{
+		JID:                 "some-uuid",
+		CurrentStage:        "Fork",
+		LastCheckpointStage: "Init",
+		Regions:             map[string]model.JourneyRegion{"documents": {CurrentStage: "DocumentsPending"}},
+	}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Skip", Region: "documents"})
+
+	suite.Empty(response)
+	suite.Equal(fsmErrors.InvalidEventError("DocumentsPending", "Skip"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldStopRegionChain_WhenRegionReachesFinalState() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Fork"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "Fork",
+			NextScreen:   "ForkScreen",
+			StateHandler: suite.mockStateHandler,
+			ParallelRegions: []model.ParallelRegion{
+				{Name: "documents", InitialStateName: "DocumentsPending", FinalStateNames: []string{"DocumentsUploaded"}},
+			},
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Join"}},
+		},
+		{
+			Name:                "DocumentsPending",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Upload", DestinationStateName: "DocumentsUploaded"}},
+		},
+		{
+			Name:                "DocumentsUploaded",
+			NextScreen:          "DocumentsUploadedScreen",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "INTERNAL_Archive", DestinationStateName: "DocumentsArchived"}},
+		},
+		{
+			Name:         "DocumentsArchived",
+			StateHandler: suite.mockStateHandler,
+		},
+		{
+			Name:         "Join",
+			NextScreen:   "JoinScreen",
+			StateHandler: suite.mockStateHandler,
+			IsJoin:       true,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{
+		JID:                 "some-uuid",
+		CurrentStage:        "Fork",
+		LastCheckpointStage: "Init",
+		Regions:             map[string]model.JourneyRegion{"documents": {CurrentStage: "DocumentsPending"}},
+	}
+	expectedJourney := model.Journey[testJourneyData]{
+		JID:                 "some-uuid",
+		CurrentStage:        "Fork",
+		LastCheckpointStage: "Init",
+		Regions:             map[string]model.JourneyRegion{"documents": {CurrentStage: "DocumentsUploaded", IsComplete: true}},
+	}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "INTERNAL_Archive", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Upload", Region: "documents"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", NextScreen: "DocumentsUploadedScreen"}, response)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnConflict_WhenForkStateDoesNotDefineRegion() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Fork"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "Fork",
+			NextScreen:   "ForkScreen",
+			StateHandler: suite.mockStateHandler,
+			ParallelRegions: []model.ParallelRegion{
+				{Name: "documents", InitialStateName: "DocumentsPending", FinalStateNames: []string{"DocumentsUploaded"}},
+			},
+		},
+		{
+			Name:                "DocumentsPending",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Upload", DestinationStateName: "DocumentsUploaded"}},
+		},
+		{
+			Name:         "DocumentsUploaded",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{
+		JID:                 "some-uuid",
+		CurrentStage:        "Fork",
+		LastCheckpointStage: "Init",
+		Regions:             map[string]model.JourneyRegion{"nominee": {CurrentStage: "DocumentsPending"}},
+	}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Upload", Region: "nominee"})
+
+	suite.Empty(response)
+	suite.Equal(fsmErrors.ConflictError("region nominee is not defined by state Fork"), err)
+}
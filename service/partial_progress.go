@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Novato-Now/novato-fsm/model"
+	"github.com/Novato-Now/novato-utils/logging"
+)
+
+func (fs fsmService[T]) persistPartialProgress(
+	ctx context.Context,
+	previousJourney model.Journey[T],
+	failedState model.FsmState,
+	steps []executedStep[T],
+) (model.FsmResponse, []executedStep[T], bool) {
+	log := logging.GetLogger(ctx)
+
+	policy := fs.partialProgressPolicy
+	if failedState.PartialProgressPolicy != "" {
+		policy = failedState.PartialProgressPolicy
+	}
+
+	persistedStepIndex := -1
+	switch policy {
+	case model.PartialProgressPersistLastSuccessfulState:
+		persistedStepIndex = len(steps) - 1
+	case model.PartialProgressPersistLastCheckpoint:
+		for i := len(steps) - 1; i >= 0; i-- {
+			if steps[i].state.IsCheckpoint {
+				persistedStepIndex = i
+				break
+			}
+		}
+	}
+	if persistedStepIndex < 0 {
+		return model.FsmResponse{}, steps, false
+	}
+
+	step := steps[persistedStepIndex]
+	log.Infof("Persisting partial progress of journey %s up to state %s", step.journey.JID, step.state.Name)
+	err := fs.journeyStore.Save(ctx, step.journey)
+	if err != nil {
+		log.Errorf("Unable to save partial progress. Error: %+v", err)
+		return model.FsmResponse{}, steps, false
+	}
+	fs.onJourneySaved(ctx, previousJourney, step.journey, true)
+
+	return fs.loadFsmResponse(step.journey, step.state, step.response), steps[persistedStepIndex+1:], true
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/model"
+	nuErrors "github.com/Novato-Now/novato-utils/errors"
+	"go.uber.org/mock/gomock"
+)
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldPersistUpToLastCheckpoint_WhenChainFailsWithCheckpointPolicy() {
+	expectedError := nuErrors.InternalSystemError(suite.ctx).WithMessage("some-error")
+	var compensatedStates []string
+	compensate := func(stateName string) model.StateCompensation {
+		return func(ctx context.Context, jID string, journeyData any) *nuErrors.Error {
+			compensatedStates = append(compensatedStates, stateName)
+			return nil
+		}
+	}
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:                "StateA",
+			NextScreen:          "ScreenA",
+			StateHandler:        suite.mockStateHandler,
+			IsCheckpoint:        true,
+			Compensate:          compensate("StateA"),
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "INTERNAL_Next", DestinationStateName: "StateB"}},
+		},
+		{
+			Name:                "StateB",
+			NextScreen:          "ScreenB",
+			StateHandler:        suite.mockStateHandler,
+			Compensate:          compensate("StateB"),
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "INTERNAL_Next", DestinationStateName: "StateC"}},
+		},
+		{
+			Name:                  "StateC",
+			StateHandler:          suite.mockStateHandler,
+			PartialProgressPolicy: "",
+		},
+	}
+	service, err := NewFsmService(
+		initState,
+		nonInitStates,
+		suite.mockJourneyStore,
+		model.FsmHooks[testJourneyData]{},
+		WithPartialProgressPolicy[testJourneyData](model.PartialProgressPersistLastCheckpoint),
+	)
+	suite.Nil(err)
+
+	journeyDataA := testJourneyData{StateACompleted: true}
+	journeyDataB := testJourneyData{StateACompleted: true, StateBCompleted: true}
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateA", LastCheckpointStage: "StateA", Data: journeyDataA}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	gomock.InOrder(
+		suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return("response-a", journeyDataA, "INTERNAL_Next", nil),
+		suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", journeyDataA, "response-a").Return("response-b", journeyDataB, "INTERNAL_Next", nil),
+		suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", journeyDataB, "response-b").Return(nil, nil, "", expectedError),
+	)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", NextScreen: "ScreenA", Data: "response-a"}, response)
+	suite.Equal(fsmErrors.HandlerFailedError("StateC", expectedError), err)
+	suite.Equal([]string{"StateB"}, compensatedStates)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldPersistUpToLastSuccessfulState_WhenFailingStateOverridesPolicy() {
+	expectedError := nuErrors.InternalSystemError(suite.ctx).WithMessage("some-error")
+	var compensatedStates []string
+	compensate := func(stateName string) model.StateCompensation {
+		return func(ctx context.Context, jID string, journeyData any) *nuErrors.Error {
+			compensatedStates = append(compensatedStates, stateName)
+			return nil
+		}
+	}
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:                "StateA",
+			NextScreen:          "ScreenA",
+			StateHandler:        suite.mockStateHandler,
+			IsCheckpoint:        true,
+			Compensate:          compensate("StateA"),
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "INTERNAL_Next", DestinationStateName: "StateB"}},
+		},
+		{
+			Name:                "StateB",
+			NextScreen:          "ScreenB",
+			StateHandler:        suite.mockStateHandler,
+			Compensate:          compensate("StateB"),
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "INTERNAL_Next", DestinationStateName: "StateC"}},
+		},
+		{
+			Name:                  "StateC",
+			StateHandler:          suite.mockStateHandler,
+			PartialProgressPolicy: model.PartialProgressPersistLastSuccessfulState,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journeyDataA := testJourneyData{StateACompleted: true}
+	journeyDataB := testJourneyData{StateACompleted: true, StateBCompleted: true}
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateB", LastCheckpointStage: "StateA", Data: journeyDataB}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	gomock.InOrder(
+		suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return("response-a", journeyDataA, "INTERNAL_Next", nil),
+		suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", journeyDataA, "response-a").Return("response-b", journeyDataB, "INTERNAL_Next", nil),
+		suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", journeyDataB, "response-b").Return(nil, nil, "", expectedError),
+	)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", NextScreen: "ScreenB", Data: "response-b"}, response)
+	suite.Equal(fsmErrors.HandlerFailedError("StateC", expectedError), err)
+	suite.Empty(compensatedStates)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldPersistNothing_WhenChainFailsWithDefaultPolicy() {
+	expectedError := nuErrors.InternalSystemError(suite.ctx).WithMessage("some-error")
+	var compensatedStates []string
+	compensate := func(stateName string) model.StateCompensation {
+		return func(ctx context.Context, jID string, journeyData any) *nuErrors.Error {
+			compensatedStates = append(compensatedStates, stateName)
+			return nil
+		}
+	}
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:                "StateA",
+			NextScreen:          "ScreenA",
+			StateHandler:        suite.mockStateHandler,
+			IsCheckpoint:        true,
+			Compensate:          compensate("StateA"),
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "INTERNAL_Next", DestinationStateName: "StateB"}},
+		},
+		{
+			Name:                "StateB",
+			NextScreen:          "ScreenB",
+			StateHandler:        suite.mockStateHandler,
+			Compensate:          compensate("StateB"),
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "INTERNAL_Next", DestinationStateName: "StateC"}},
+		},
+		{
+			Name:                  "StateC",
+			StateHandler:          suite.mockStateHandler,
+			PartialProgressPolicy: "",
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journeyDataA := testJourneyData{StateACompleted: true}
+	journeyDataB := testJourneyData{StateACompleted: true, StateBCompleted: true}
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	gomock.InOrder(
+		suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return("response-a", journeyDataA, "INTERNAL_Next", nil),
+		suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", journeyDataA, "response-a").Return("response-b", journeyDataB, "INTERNAL_Next", nil),
+		suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", journeyDataB, "response-b").Return(nil, nil, "", expectedError),
+	)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next"})
+
+	suite.Empty(response)
+	suite.Equal(fsmErrors.HandlerFailedError("StateC", expectedError), err)
+	suite.Equal([]string{"StateB", "StateA"}, compensatedStates)
+}
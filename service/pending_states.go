@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Novato-Now/novato-fsm/constants"
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/model"
+	nuErrors "github.com/Novato-Now/novato-utils/errors"
+	"github.com/Novato-Now/novato-utils/logging"
+)
+
+// CorrelationResolver maps a key chosen by an external system, such as a provider reference, to the jID it belongs to.
+type CorrelationResolver func(ctx context.Context, correlationKey string) (jID string, err *nuErrors.Error)
+
+// CompletePending delivers the external event a pending journey is waiting for and continues the chain through Execute.
+// The journey is found by jID, or by correlation key when a CorrelationResolver or correlation store is configured.
+func (fs fsmService[T]) CompletePending(ctx context.Context, request model.CompletionRequest) (model.FsmResponse, *nuErrors.Error) {
+	log := logging.GetLogger(ctx)
+	jID, err := fs.resolveJID(ctx, request)
+	if err != nil {
+		return model.FsmResponse{}, err
+	}
+	journey, err := fs.journeyStore.Get(ctx, jID)
+	if err != nil {
+		log.Errorf("Error from journey store. Error %+v", err)
+		return model.FsmResponse{}, err
+	}
+	if !journey.IsPending {
+		log.Errorf("Journey %s is not waiting for an external event", jID)
+		return model.FsmResponse{}, fsmErrors.ConflictError(fmt.Sprintf("journey %s is not waiting for an external event", jID))
+	}
+
+	log.Infof("Completing pending state %s of journey %s with event %s", journey.CurrentStage, jID, request.Event)
+	return fs.execute(ctx, model.FsmRequest{JID: jID, Event: request.Event, Data: request.Data}, executeOptions{completesPending: true})
+}
+
+func (fs fsmService[T]) resolveJID(ctx context.Context, request model.CompletionRequest) (string, *nuErrors.Error) {
+	log := logging.GetLogger(ctx)
+	if request.JID != "" {
+		return request.JID, nil
+	}
+	if request.CorrelationKey == "" || (fs.correlationResolver == nil && fs.correlationStore == nil) {
+		log.Error("Completion request without jID or resolvable correlation key")
+		return "", fsmErrors.ValidationError().WithMessage("jID or correlation key is required")
+	}
+	if fs.correlationResolver == nil {
+		return fs.lookupCorrelationKey(ctx, request.CorrelationKey)
+	}
+	jID, err := fs.correlationResolver(ctx, request.CorrelationKey)
+	if err != nil {
+		log.Errorf("Unable to resolve correlation key %s. Error: %+v", request.CorrelationKey, err)
+		return "", err
+	}
+	return jID, nil
+}
+
+// executeOptions tell execute where a request comes from, which decides the events it may deliver to a pending journey.
+type executeOptions struct {
+	completesPending bool
+	firesTimer       bool
+}
+
+// checkPendingEvent lets only Back, Resume, the completion events of the pending state delivered by the external
+// system and the events of its timers reach a journey that waits for an external event.
+func (fs fsmService[T]) checkPendingEvent(ctx context.Context, journey model.Journey[T], event string, opts executeOptions) *nuErrors.Error {
+	if !journey.IsPending || event == constants.EventNameBack || event == constants.EventNameResume {
+		return nil
+	}
+	log := logging.GetLogger(ctx)
+	state, err := fs.getState(ctx, journey.CurrentStage)
+	if err != nil {
+		return err
+	}
+	if opts.completesPending && slices.Contains(completionEvents(state), event) {
+		return nil
+	}
+	if opts.firesTimer && slices.ContainsFunc(state.Timers, func(timer model.StateTimer) bool { return timer.Event == event }) {
+		return nil
+	}
+	log.Errorf("Event %s is not allowed while journey is waiting for an external event", event)
+	return fsmErrors.ConflictError(fmt.Sprintf("journey %s is waiting for an external event", journey.JID))
+}
+
+// completionEvents are the events declared to complete an async state, or all of its own events except Back if none are declared.
+func completionEvents(state model.FsmState) []string {
+	if len(state.CompletionEvents) > 0 {
+		return state.CompletionEvents
+	}
+	var events []string
+	for _, nextAvailableEvent := range state.NextAvailableEvents {
+		if nextAvailableEvent.Event != constants.EventNameBack {
+			events = append(events, nextAvailableEvent.Event)
+		}
+	}
+	return events
+}
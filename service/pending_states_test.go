@@ -0,0 +1,482 @@
+package service
+
+import (
+	"context"
+
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/model"
+	nuErrors "github.com/Novato-Now/novato-utils/errors"
+)
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldSaveJourneyAsPending_WhenAsyncStateReturnsPending() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "VerifyAccount", DestinationStateName: "AccountVerification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "AccountVerification",
+			NextScreen:   "VerificationInProgressScreen",
+			StateHandler: suite.mockStateHandler,
+			IsAsync:      true,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "AccountVerified", DestinationStateName: "Verified"},
+				{Event: "Back", DestinationStateName: "Init"},
+			},
+		},
+		{
+			Name:         "Verified",
+			NextScreen:   "VerifiedScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "AccountVerification", LastCheckpointStage: "Init", IsPending: true}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, "account").Return("requested", testJourneyData{}, "Pending", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "VerifyAccount", Data: "account"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", Data: "requested", NextScreen: "VerificationInProgressScreen", IsPending: true}, response)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnInvalidEvent_WhenSyncStateReturnsPending() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "VerifyAccount", DestinationStateName: "AccountVerification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "AccountVerification",
+			NextScreen:   "VerificationInProgressScreen",
+			StateHandler: suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "AccountVerified", DestinationStateName: "Verified"},
+				{Event: "Back", DestinationStateName: "Init"},
+			},
+		},
+		{
+			Name:         "Verified",
+			NextScreen:   "VerifiedScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "Pending", nil).Times(1)
+
+	_, err = service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "VerifyAccount"})
+
+	suite.Equal(fsmErrors.InvalidEventError("AccountVerification", "Pending"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnConflict_WhenClientSendsEventToPendingJourney() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "VerifyAccount", DestinationStateName: "AccountVerification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "AccountVerification",
+			NextScreen:   "VerificationInProgressScreen",
+			StateHandler: suite.mockStateHandler,
+			IsAsync:      true,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "AccountVerified", DestinationStateName: "Verified"},
+				{Event: "Back", DestinationStateName: "Init"},
+			},
+		},
+		{
+			Name:         "Verified",
+			NextScreen:   "VerifiedScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "AccountVerification", LastCheckpointStage: "Init", IsPending: true}
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+
+	_, err = service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "AccountVerified"})
+
+	suite.Equal(fsmErrors.ConflictError("journey some-uuid is waiting for an external event"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldClearPending_WhenClientGoesBack() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "VerifyAccount", DestinationStateName: "AccountVerification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "AccountVerification",
+			NextScreen:   "VerificationInProgressScreen",
+			StateHandler: suite.mockStateHandler,
+			IsAsync:      true,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "AccountVerified", DestinationStateName: "Verified"},
+				{Event: "Back", DestinationStateName: "Init"},
+			},
+		},
+		{
+			Name:         "Verified",
+			NextScreen:   "VerifiedScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "AccountVerification", LastCheckpointStage: "Init", IsPending: true}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Revisit(suite.ctx, "some-uuid", testJourneyData{}).Return(nil, testJourneyData{}, nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Back"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", NextScreen: "InitScreen"}, response)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestCompletePending_ShouldContinueChain_WhenJourneyIsPending() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "VerifyAccount", DestinationStateName: "AccountVerification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "AccountVerification",
+			NextScreen:   "VerificationInProgressScreen",
+			StateHandler: suite.mockStateHandler,
+			IsAsync:      true,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "AccountVerified", DestinationStateName: "Verified"},
+				{Event: "Back", DestinationStateName: "Init"},
+			},
+		},
+		{
+			Name:         "Verified",
+			NextScreen:   "VerifiedScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "AccountVerification", LastCheckpointStage: "Init", IsPending: true}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Verified", LastCheckpointStage: "Init"}
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(2)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, "webhook").Return("verified", testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.CompletePending(suite.ctx, model.CompletionRequest{JID: "some-uuid", Event: "AccountVerified", Data: "webhook"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", Data: "verified", NextScreen: "VerifiedScreen"}, response)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestCompletePending_ShouldResolveJourneyByCorrelationKey() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "VerifyAccount", DestinationStateName: "AccountVerification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "AccountVerification",
+			NextScreen:   "VerificationInProgressScreen",
+			StateHandler: suite.mockStateHandler,
+			IsAsync:      true,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "AccountVerified", DestinationStateName: "Verified"},
+				{Event: "Back", DestinationStateName: "Init"},
+			},
+		},
+		{
+			Name:         "Verified",
+			NextScreen:   "VerifiedScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	resolver := func(_ context.Context, correlationKey string) (string, *nuErrors.Error) {
+		suite.Equal("bank-ref-1", correlationKey)
+		return "some-uuid", nil
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{},
+		WithCorrelationResolver[testJourneyData](resolver))
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "AccountVerification", LastCheckpointStage: "Init", IsPending: true}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Verified", LastCheckpointStage: "Init"}
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(2)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.CompletePending(suite.ctx, model.CompletionRequest{CorrelationKey: "bank-ref-1", Event: "AccountVerified"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", NextScreen: "VerifiedScreen"}, response)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestCompletePending_ShouldReturnConflict_WhenJourneyIsNotPending() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "VerifyAccount", DestinationStateName: "AccountVerification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "AccountVerification",
+			NextScreen:   "VerificationInProgressScreen",
+			StateHandler: suite.mockStateHandler,
+			IsAsync:      true,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "AccountVerified", DestinationStateName: "Verified"},
+				{Event: "Back", DestinationStateName: "Init"},
+			},
+		},
+		{
+			Name:         "Verified",
+			NextScreen:   "VerifiedScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Verified", LastCheckpointStage: "Init"}
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+
+	_, err = service.CompletePending(suite.ctx, model.CompletionRequest{JID: "some-uuid", Event: "AccountVerified"})
+
+	suite.Equal(fsmErrors.ConflictError("journey some-uuid is not waiting for an external event"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestCompletePending_ShouldReturnValidationError_WhenJourneyCannotBeIdentified() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "VerifyAccount", DestinationStateName: "AccountVerification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "AccountVerification",
+			NextScreen:   "VerificationInProgressScreen",
+			StateHandler: suite.mockStateHandler,
+			IsAsync:      true,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "AccountVerified", DestinationStateName: "Verified"},
+				{Event: "Back", DestinationStateName: "Init"},
+			},
+		},
+		{
+			Name:         "Verified",
+			NextScreen:   "VerifiedScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	_, err = service.CompletePending(suite.ctx, model.CompletionRequest{CorrelationKey: "bank-ref-1", Event: "AccountVerified"})
+
+	suite.Equal(fsmErrors.ValidationError().WithMessage("jID or correlation key is required"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestGetProgress_ShouldReportPending_WhenJourneyIsWaiting() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "VerifyAccount", DestinationStateName: "AccountVerification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "AccountVerification",
+			NextScreen:   "VerificationInProgressScreen",
+			StateHandler: suite.mockStateHandler,
+			IsAsync:      true,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "AccountVerified", DestinationStateName: "Verified"},
+				{Event: "Back", DestinationStateName: "Init"},
+			},
+		},
+		{
+			Name:         "Verified",
+			NextScreen:   "VerifiedScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "AccountVerification", LastCheckpointStage: "Init", IsPending: true}
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+
+	progress, err := service.GetProgress(suite.ctx, "some-uuid")
+
+	suite.Nil(err)
+	suite.True(progress.IsPending)
+}
+
+func (suite *fsmServiceTestSuite) TestCompletePending_ShouldReturnConflict_WhenEventIsNotDeclaredCompletionEvent() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "VerifyAccount", DestinationStateName: "AccountVerification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:             "AccountVerification",
+			NextScreen:       "VerificationInProgressScreen",
+			StateHandler:     suite.mockStateHandler,
+			IsAsync:          true,
+			CompletionEvents: []string{"AccountVerified"},
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "AccountVerified", DestinationStateName: "Verified"},
+				{Event: "SkipVerification", DestinationStateName: "Verified"},
+			},
+		},
+		{
+			Name:         "Verified",
+			NextScreen:   "VerifiedScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "AccountVerification", LastCheckpointStage: "Init", IsPending: true}
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(2)
+
+	_, err = service.CompletePending(suite.ctx, model.CompletionRequest{JID: "some-uuid", Event: "SkipVerification"})
+
+	suite.Equal(fsmErrors.ConflictError("journey some-uuid is waiting for an external event"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestCompletePending_ShouldReturnConflict_WhenEventIsGlobalEvent() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "VerifyAccount", DestinationStateName: "AccountVerification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:                "AccountVerification",
+			NextScreen:          "VerificationInProgressScreen",
+			StateHandler:        suite.mockStateHandler,
+			IsAsync:             true,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "AccountVerified", DestinationStateName: "Verified"}},
+		},
+		{
+			Name:         "Verified",
+			NextScreen:   "VerifiedScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{},
+		WithGlobalEvents[testJourneyData](model.NextAvailableEvent{Event: "Cancel", DestinationStateName: "Init"}))
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "AccountVerification", LastCheckpointStage: "Init", IsPending: true}
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(2)
+
+	_, err = service.CompletePending(suite.ctx, model.CompletionRequest{JID: "some-uuid", Event: "Cancel"})
+
+	suite.Equal(fsmErrors.ConflictError("journey some-uuid is waiting for an external event"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldOnlyOfferBackInNavigation_WhenJourneyIsPending() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "VerifyAccount", DestinationStateName: "AccountVerification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "AccountVerification",
+			NextScreen:   "VerificationInProgressScreen",
+			StateHandler: suite.mockStateHandler,
+			IsAsync:      true,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "AccountVerified", DestinationStateName: "Verified"},
+				{Event: "Back", DestinationStateName: "Init"},
+			},
+		},
+		{
+			Name:         "Verified",
+			NextScreen:   "VerifiedScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(
+		initState,
+		nonInitStates,
+		suite.mockJourneyStore,
+		model.FsmHooks[testJourneyData]{},
+		WithGlobalEvents[testJourneyData](model.NextAvailableEvent{Event: "Cancel", DestinationStateName: "Init"}),
+		WithNavigationInResponse[testJourneyData](),
+	)
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "AccountVerification", LastCheckpointStage: "Init", IsPending: true}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, "account").Return("requested", testJourneyData{}, "Pending", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "VerifyAccount", Data: "account"})
+
+	suite.Equal(
+		model.FsmResponse{
+			JID:        "some-uuid",
+			Data:       "requested",
+			NextScreen: "VerificationInProgressScreen",
+			Navigation: &model.Navigation{StateName: "AccountVerification", AvailableEvents: []string{}, CanGoBack: true},
+			IsPending:  true,
+		},
+		response,
+	)
+	suite.Nil(err)
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"math"
+	"slices"
+
+	"github.com/Novato-Now/novato-fsm/constants"
+	"github.com/Novato-Now/novato-fsm/model"
+	nuErrors "github.com/Novato-Now/novato-utils/errors"
+	"github.com/Novato-Now/novato-utils/logging"
+)
+
+func (fs fsmService[T]) GetProgress(ctx context.Context, jID string) (model.Progress, *nuErrors.Error) {
+	log := logging.GetLogger(ctx)
+	journey, err := fs.journeyStore.Get(ctx, jID)
+	if err != nil {
+		log.Errorf("Error from journey store. Error %+v", err)
+		return model.Progress{}, err
+	}
+	fs, err = fs.forJourney(ctx, journey)
+	if err != nil {
+		return model.Progress{}, err
+	}
+	state, err := fs.getState(ctx, journey.CurrentStage)
+	if err != nil {
+		return model.Progress{}, err
+	}
+	return fs.progressOf(journey, state), nil
+}
+
+func (fs fsmService[T]) progressOf(journey model.Journey[T], state model.FsmState) model.Progress {
+	progress := fs.stateProgress[state.Name]
+	progress.IsPending = journey.IsPending
+	if journey.IsCompleted() {
+		progress.MinStepsRemaining = 0
+		progress.MaxStepsRemaining = 0
+		progress.Percent = 100
+	}
+	return progress
+}
+
+// computeStateProgress walks the flow graph from the initial state, ignoring Back events and edges that close a
+// cycle, and derives for every state the weighted steps taken along the longest path to it and the shortest and
+// longest weighted paths from it to a terminal state. Besides the events of a state, the graph follows the global
+// events it does not exclude, its error transitions and its parallel regions, whose final states lead on to the
+// states the fork state moves to.
+func computeStateProgress(states map[string]model.FsmState, initialStateName string, globalEvents []model.NextAvailableEvent) map[string]model.Progress {
+	regionExits := make(map[string][]string)
+	for _, state := range states {
+		for _, region := range state.ParallelRegions {
+			for _, finalStateName := range region.FinalStateNames {
+				for _, nextAvailableEvent := range state.NextAvailableEvents {
+					if nextAvailableEvent.Event != constants.EventNameBack {
+						regionExits[finalStateName] = append(regionExits[finalStateName], nextAvailableEvent.DestinationStateName)
+					}
+				}
+			}
+		}
+	}
+	destinationsOf := func(state model.FsmState) []string {
+		var destinations []string
+		for _, nextAvailableEvent := range state.NextAvailableEvents {
+			if nextAvailableEvent.Event != constants.EventNameBack {
+				destinations = append(destinations, nextAvailableEvent.DestinationStateName)
+			}
+		}
+		for _, globalEvent := range globalEvents {
+			_, isStateEvent := findNextAvailableEvent(state.NextAvailableEvents, globalEvent.Event)
+			if globalEvent.Event != constants.EventNameBack && !isStateEvent && !slices.Contains(state.ExcludedGlobalEvents, globalEvent.Event) {
+				destinations = append(destinations, globalEvent.DestinationStateName)
+			}
+		}
+		for _, errorTransition := range state.ErrorTransitions {
+			destinations = append(destinations, errorTransition.DestinationStateName)
+		}
+		for _, region := range state.ParallelRegions {
+			destinations = append(destinations, region.InitialStateName)
+		}
+		return append(destinations, regionExits[state.Name]...)
+	}
+
+	forwardEdges := make(map[string][]string, len(states))
+	var topologicalOrder []string
+	visited := make(map[string]bool, len(states))
+	onStack := make(map[string]bool, len(states))
+
+	var visit func(stateName string)
+	visit = func(stateName string) {
+		visited[stateName] = true
+		onStack[stateName] = true
+		state := states[stateName]
+		if state.TerminalStatus == "" {
+			for _, destination := range destinationsOf(state) {
+				if onStack[destination] {
+					continue
+				}
+				if _, ok := states[destination]; !ok || slices.Contains(forwardEdges[stateName], destination) {
+					continue
+				}
+				forwardEdges[stateName] = append(forwardEdges[stateName], destination)
+				if !visited[destination] {
+					visit(destination)
+				}
+			}
+		}
+		onStack[stateName] = false
+		topologicalOrder = append(topologicalOrder, stateName)
+	}
+
+	if _, ok := states[initialStateName]; ok {
+		visit(initialStateName)
+	}
+	stateNames := make([]string, 0, len(states))
+	for stateName := range states {
+		stateNames = append(stateNames, stateName)
+	}
+	slices.Sort(stateNames)
+	for _, stateName := range stateNames {
+		if !visited[stateName] {
+			visit(stateName)
+		}
+	}
+	slices.Reverse(topologicalOrder)
+
+	weightOf := func(stateName string) float64 {
+		if weight := states[stateName].ProgressWeight; weight > 0 {
+			return weight
+		}
+		return 1
+	}
+
+	stepsCompleted := make(map[string]float64, len(states))
+	if _, ok := states[initialStateName]; ok {
+		stepsCompleted[initialStateName] = weightOf(initialStateName)
+	}
+	for _, stateName := range topologicalOrder {
+		completed, reachable := stepsCompleted[stateName]
+		if !reachable {
+			continue
+		}
+		for _, destination := range forwardEdges[stateName] {
+			stepsCompleted[destination] = math.Max(stepsCompleted[destination], completed+weightOf(destination))
+		}
+	}
+
+	minRemaining := make(map[string]float64, len(states))
+	maxRemaining := make(map[string]float64, len(states))
+	for i := len(topologicalOrder) - 1; i >= 0; i-- {
+		stateName := topologicalOrder[i]
+		if len(forwardEdges[stateName]) == 0 {
+			continue
+		}
+		minRemaining[stateName] = math.Inf(1)
+		for _, destination := range forwardEdges[stateName] {
+			minRemaining[stateName] = math.Min(minRemaining[stateName], weightOf(destination)+minRemaining[destination])
+			maxRemaining[stateName] = math.Max(maxRemaining[stateName], weightOf(destination)+maxRemaining[destination])
+		}
+	}
+
+	stateProgress := make(map[string]model.Progress, len(states))
+	for _, stateName := range topologicalOrder {
+		progress := model.Progress{
+			StepsCompleted:    stepsCompleted[stateName],
+			MinStepsRemaining: minRemaining[stateName],
+			MaxStepsRemaining: maxRemaining[stateName],
+		}
+		if total := progress.StepsCompleted + progress.MaxStepsRemaining; total > 0 {
+			progress.Percent = math.Round(progress.StepsCompleted / total * 100)
+		}
+		stateProgress[stateName] = progress
+	}
+	return stateProgress
+}
@@ -0,0 +1,305 @@
+package service
+
+import (
+	"github.com/Novato-Now/novato-fsm/model"
+)
+
+func (suite *fsmServiceTestSuite) TestGetProgress_ShouldReturnProgressFromFlowGraph_WhenJourneyExists() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "StateA",
+			NextScreen:   "ScreenA",
+			StateHandler: suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "Back", DestinationStateName: "Init"},
+				{Event: "Short", DestinationStateName: "StateB"},
+				{Event: "Long", DestinationStateName: "StateC"},
+			},
+		},
+		{
+			Name:                "StateB",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Done"}},
+		},
+		{
+			Name:                "StateC",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateD"}},
+		},
+		{
+			Name:           "StateD",
+			StateHandler:   suite.mockStateHandler,
+			ProgressWeight: 2,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "Retry", DestinationStateName: "StateC"},
+				{Event: "Next", DestinationStateName: "Done"},
+			},
+		},
+		{
+			Name:           "Done",
+			StateHandler:   suite.mockStateHandler,
+			TerminalStatus: model.JourneyStatusSucceeded,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	suite.mockJourneyStore.EXPECT().
+		Get(suite.ctx, "some-uuid").
+		Return(model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateA", LastCheckpointStage: "Init"}, nil).
+		Times(1)
+
+	progress, err := service.GetProgress(suite.ctx, "some-uuid")
+
+	suite.Equal(model.Progress{StepsCompleted: 2, MinStepsRemaining: 2, MaxStepsRemaining: 4, Percent: 33}, progress)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestGetProgress_ShouldReturnFullProgress_WhenJourneyIsInTerminalState() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "StateA",
+			NextScreen:   "ScreenA",
+			StateHandler: suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "Back", DestinationStateName: "Init"},
+				{Event: "Short", DestinationStateName: "StateB"},
+				{Event: "Long", DestinationStateName: "StateC"},
+			},
+		},
+		{
+			Name:                "StateB",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Done"}},
+		},
+		{
+			Name:                "StateC",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateD"}},
+		},
+		{
+			Name:           "StateD",
+			StateHandler:   suite.mockStateHandler,
+			ProgressWeight: 2,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "Retry", DestinationStateName: "StateC"},
+				{Event: "Next", DestinationStateName: "Done"},
+			},
+		},
+		{
+			Name:           "Done",
+			StateHandler:   suite.mockStateHandler,
+			TerminalStatus: model.JourneyStatusSucceeded,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	suite.mockJourneyStore.EXPECT().
+		Get(suite.ctx, "some-uuid").
+		Return(model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Done", Status: model.JourneyStatusSucceeded}, nil).
+		Times(1)
+
+	progress, err := service.GetProgress(suite.ctx, "some-uuid")
+
+	suite.Equal(model.Progress{StepsCompleted: 6, Percent: 100}, progress)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnProgress_WhenProgressInResponseIsEnabled() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "StateA",
+			NextScreen:   "ScreenA",
+			StateHandler: suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "Back", DestinationStateName: "Init"},
+				{Event: "Short", DestinationStateName: "StateB"},
+				{Event: "Long", DestinationStateName: "StateC"},
+			},
+		},
+		{
+			Name:                "StateB",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Done"}},
+		},
+		{
+			Name:                "StateC",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateD"}},
+		},
+		{
+			Name:           "StateD",
+			StateHandler:   suite.mockStateHandler,
+			ProgressWeight: 2,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "Retry", DestinationStateName: "StateC"},
+				{Event: "Next", DestinationStateName: "Done"},
+			},
+		},
+		{
+			Name:           "Done",
+			StateHandler:   suite.mockStateHandler,
+			TerminalStatus: model.JourneyStatusSucceeded,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{}, WithProgressInResponse[testJourneyData]())
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateA", LastCheckpointStage: "Init"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateC", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Long"})
+
+	suite.Equal(
+		model.FsmResponse{
+			JID:      "some-uuid",
+			Progress: &model.Progress{StepsCompleted: 3, MinStepsRemaining: 3, MaxStepsRemaining: 3, Percent: 50},
+		},
+		response,
+	)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestGetProgress_ShouldFollowGlobalEventsAndErrorTransitions() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:                "StateA",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateB"}},
+			ErrorTransitions:    []model.ErrorTransition{{DestinationStateName: "Failed"}},
+		},
+		{
+			Name:                "StateB",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Done"}},
+		},
+		{
+			Name:           "Done",
+			StateHandler:   suite.mockStateHandler,
+			TerminalStatus: model.JourneyStatusSucceeded,
+		},
+		{
+			Name:           "Failed",
+			StateHandler:   suite.mockStateHandler,
+			TerminalStatus: model.JourneyStatusFailed,
+		},
+		{
+			Name:           "Cancelled",
+			StateHandler:   suite.mockStateHandler,
+			TerminalStatus: model.JourneyStatusFailed,
+		},
+	}
+	service, err := NewFsmService(
+		initState,
+		nonInitStates,
+		suite.mockJourneyStore,
+		model.FsmHooks[testJourneyData]{},
+		WithGlobalEvents[testJourneyData](model.NextAvailableEvent{Event: "Cancel", DestinationStateName: "Cancelled"}),
+	)
+	suite.Nil(err)
+
+	suite.mockJourneyStore.EXPECT().
+		Get(suite.ctx, "some-uuid").
+		Return(model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateB", LastCheckpointStage: "Init"}, nil).
+		Times(1)
+
+	progress, err := service.GetProgress(suite.ctx, "some-uuid")
+
+	suite.Equal(model.Progress{StepsCompleted: 3, MinStepsRemaining: 1, MaxStepsRemaining: 1, Percent: 75}, progress)
+	suite.Nil(err)
+
+	suite.mockJourneyStore.EXPECT().
+		Get(suite.ctx, "some-uuid").
+		Return(model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Cancelled", LastCheckpointStage: "Init"}, nil).
+		Times(1)
+
+	progress, err = service.GetProgress(suite.ctx, "some-uuid")
+
+	suite.Equal(model.Progress{StepsCompleted: 4, Percent: 100}, progress)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestGetProgress_ShouldCountRegionStates_WhenFlowHasParallelRegions() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Fork"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "Fork",
+			StateHandler: suite.mockStateHandler,
+			ParallelRegions: []model.ParallelRegion{
+				{Name: "documents", InitialStateName: "DocumentsPending", FinalStateNames: []string{"DocumentsUploaded"}},
+			},
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Join"}},
+		},
+		{
+			Name:                "DocumentsPending",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Upload", DestinationStateName: "DocumentsUploaded"}},
+		},
+		{
+			Name:         "DocumentsUploaded",
+			StateHandler: suite.mockStateHandler,
+		},
+		{
+			Name:                "Join",
+			StateHandler:        suite.mockStateHandler,
+			IsJoin:              true,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "Done"}},
+		},
+		{
+			Name:           "Done",
+			StateHandler:   suite.mockStateHandler,
+			TerminalStatus: model.JourneyStatusSucceeded,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	suite.mockJourneyStore.EXPECT().
+		Get(suite.ctx, "some-uuid").
+		Return(model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Join", LastCheckpointStage: "Init"}, nil).
+		Times(1)
+
+	progress, err := service.GetProgress(suite.ctx, "some-uuid")
+
+	suite.Equal(model.Progress{StepsCompleted: 5, MinStepsRemaining: 1, MaxStepsRemaining: 1, Percent: 83}, progress)
+	suite.Nil(err)
+}
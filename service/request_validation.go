@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/model"
+	"github.com/Novato-Now/novato-fsm/validation"
+	nuErrors "github.com/Novato-Now/novato-utils/errors"
+	"github.com/Novato-Now/novato-utils/logging"
+)
+
+func requestValidatorFor(transition model.NextAvailableEvent, nextState model.FsmState) validation.RequestValidator {
+	if transition.RequestValidator != nil {
+		return transition.RequestValidator
+	}
+	return nextState.RequestValidator
+}
+
+func validateRequestData(ctx context.Context, jID string, requestValidator validation.RequestValidator, data any) (model.FsmResponse, *nuErrors.Error) {
+	if requestValidator == nil {
+		return model.FsmResponse{}, nil
+	}
+	log := logging.GetLogger(ctx)
+
+	fieldErrors := requestValidator.Validate(data)
+	if len(fieldErrors) == 0 {
+		return model.FsmResponse{}, nil
+	}
+
+	messages := make([]string, 0, len(fieldErrors))
+	for _, fieldError := range fieldErrors {
+		messages = append(messages, strings.TrimSpace(fmt.Sprintf("%s %s", fieldError.Field, fieldError.Message)))
+	}
+	log.Errorf("Request data failed validation: %s", strings.Join(messages, "; "))
+
+	response := model.FsmResponse{JID: jID, Data: validation.ValidationErrors{Errors: fieldErrors}}
+	return response, fsmErrors.ValidationError().WithMessage(strings.Join(messages, "; "))
+}
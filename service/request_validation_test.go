@@ -0,0 +1,101 @@
+package service
+
+import (
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/model"
+	"github.com/Novato-Now/novato-fsm/validation"
+)
+
+type testAccountRequest struct {
+	AccountNumber string `json:"account_number" validate:"required"`
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnValidationErrors_WhenRequestDataFailsStateValidation() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:             "StateA",
+			NextScreen:       "ScreenA",
+			StateHandler:     suite.mockStateHandler,
+			RequestValidator: validation.NewStructValidator[testAccountRequest](),
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next", Data: map[string]any{}})
+
+	suite.Equal(
+		model.FsmResponse{
+			JID:  "some-uuid",
+			Data: validation.ValidationErrors{Errors: []validation.FieldError{{Field: "account_number", Message: "is required"}}},
+		},
+		response,
+	)
+	suite.Equal(fsmErrors.ValidationError().WithMessage("account_number is required"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldPreferEventValidator_WhenEdgeDeclaresValidator() {
+	initState := model.FsmState{
+		Name:         "Init",
+		NextScreen:   "InitScreen",
+		StateHandler: suite.mockStateHandler,
+		IsCheckpoint: true,
+		NextAvailableEvents: []model.NextAvailableEvent{
+			{Event: "Next", DestinationStateName: "StateA", RequestValidator: validation.NewStructValidator[struct{}]()},
+		},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:             "StateA",
+			NextScreen:       "ScreenA",
+			StateHandler:     suite.mockStateHandler,
+			RequestValidator: validation.NewStructValidator[testAccountRequest](),
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateA", LastCheckpointStage: "Init"}
+	request := map[string]any{}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, request).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next", Data: request})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", NextScreen: "ScreenA"}, response)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldNotCreateJourney_WhenStartRequestDataFailsValidation() {
+	initState := model.FsmState{
+		Name:             "Init",
+		NextScreen:       "InitScreen",
+		StateHandler:     suite.mockStateHandler,
+		IsCheckpoint:     true,
+		RequestValidator: validation.NewStructValidator[testAccountRequest](),
+	}
+	service, err := NewFsmService(initState, nil, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{Event: "Start", Data: map[string]any{"account_number": ""}})
+
+	suite.Equal(
+		model.FsmResponse{Data: validation.ValidationErrors{Errors: []validation.FieldError{{Field: "account_number", Message: "is required"}}}},
+		response,
+	)
+	suite.Equal(fsmErrors.ValidationError().WithMessage("account_number is required"), err)
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"slices"
+	"time"
+
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/model"
+	nuErrors "github.com/Novato-Now/novato-utils/errors"
+	"github.com/Novato-Now/novato-utils/logging"
+)
+
+const (
+	handlerMethodVisit   = "Visit"
+	handlerMethodRevisit = "Revisit"
+)
+
+var randFloat64 = rand.Float64
+
+var sleepContext = func(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type visitResult struct {
+	response           any
+	updatedJourneyData any
+	nextEvent          string
+}
+
+type revisitResult struct {
+	response           any
+	updatedJourneyData any
+}
+
+func (fs fsmService[T]) visitHandler(ctx context.Context, state model.FsmState, jID string, journeyData T, data any, executeDeadline time.Time) (any, any, string, *nuErrors.Error) {
+	abandoned := &abandonedHandler{}
+	result, err := withRetry(ctx, fs.hooks, state, jID, handlerMethodVisit, executeDeadline, func() (visitResult, *nuErrors.Error) {
+		return callWithDeadline(ctx, state.Name, handlerDeadline(state, executeDeadline), abandoned, func(ctx context.Context) (visitResult, *nuErrors.Error) {
+			resp, updatedJourneyData, nextEvent, err := state.StateHandler.Visit(ctx, jID, journeyData, data)
+			return visitResult{response: resp, updatedJourneyData: updatedJourneyData, nextEvent: nextEvent}, err
+		})
+	})
+	return result.response, result.updatedJourneyData, result.nextEvent, err
+}
+
+func (fs fsmService[T]) revisitHandler(ctx context.Context, state model.FsmState, jID string, journeyData T, executeDeadline time.Time) (any, any, *nuErrors.Error) {
+	abandoned := &abandonedHandler{}
+	result, err := withRetry(ctx, fs.hooks, state, jID, handlerMethodRevisit, executeDeadline, func() (revisitResult, *nuErrors.Error) {
+		return callWithDeadline(ctx, state.Name, handlerDeadline(state, executeDeadline), abandoned, func(ctx context.Context) (revisitResult, *nuErrors.Error) {
+			resp, updatedJourneyData, err := state.StateHandler.Revisit(ctx, jID, journeyData)
+			return revisitResult{response: resp, updatedJourneyData: updatedJourneyData}, err
+		})
+	})
+	return result.response, result.updatedJourneyData, err
+}
+
+// withRetry calls handler until it succeeds, its error is not retryable or the state's retry policy is exhausted.
+// A retry is skipped when its backoff would end after the context deadline or the Execute deadline.
+func withRetry[T any, R any](ctx context.Context, hooks model.FsmHooks[T], state model.FsmState, jID string, method string, executeDeadline time.Time, handler func() (R, *nuErrors.Error)) (R, *nuErrors.Error) {
+	log := logging.GetLogger(ctx)
+	policy := state.RetryPolicy
+	for attempt := 1; ; attempt++ {
+		result, err := handler()
+		if err == nil || policy == nil {
+			return result, err
+		}
+
+		backoff := retryBackoff(*policy, attempt)
+		willRetry := attempt < policy.MaxAttempts && isRetryable(*policy, err) && ctx.Err() == nil
+		if willRetry && exceedsDeadline(ctx, executeDeadline, timeNow().Add(backoff)) {
+			log.Warnf("Not retrying %s of state %s as the backoff exceeds the request deadline", method, state.Name)
+			willRetry = false
+		}
+		if hooks.OnHandlerAttempt != nil {
+			hooks.OnHandlerAttempt(model.HandlerAttempt{JID: jID, StateName: state.Name, Method: method, Attempt: attempt, Err: err, WillRetry: willRetry})
+		}
+		if !willRetry {
+			return result, err
+		}
+
+		log.Warnf("Attempt %d of %s of state %s failed, retrying in %s", attempt, method, state.Name, backoff)
+		if sleepErr := sleepContext(ctx, backoff); sleepErr != nil {
+			return result, err
+		}
+	}
+}
+
+func exceedsDeadline(ctx context.Context, executeDeadline time.Time, at time.Time) bool {
+	if deadline, ok := ctx.Deadline(); ok && at.After(deadline) {
+		return true
+	}
+	return !executeDeadline.IsZero() && at.After(executeDeadline)
+}
+
+func isRetryable(policy model.RetryPolicy, err *nuErrors.Error) bool {
+	if len(policy.RetryableErrorCodes) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(policy.RetryableErrorCodes, func(code string) bool {
+		return fsmErrors.HasCode(err, code)
+	})
+}
+
+func retryBackoff(policy model.RetryPolicy, attempt int) time.Duration {
+	multiplier := policy.Multiplier
+	if multiplier == 0 {
+		multiplier = 2
+	}
+	backoff := float64(policy.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if policy.MaxBackoff > 0 {
+		backoff = math.Min(backoff, float64(policy.MaxBackoff))
+	}
+	backoff -= backoff * policy.Jitter * randFloat64()
+	return time.Duration(backoff)
+}
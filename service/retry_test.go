@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/model"
+	nuErrors "github.com/Novato-Now/novato-utils/errors"
+	"go.uber.org/mock/gomock"
+)
+
+func stubSleep(sleeps *[]time.Duration) func() {
+	originalSleepContext := sleepContext
+	sleepContext = func(_ context.Context, d time.Duration) error {
+		*sleeps = append(*sleeps, d)
+		return nil
+	}
+	return func() { sleepContext = originalSleepContext }
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldRetryVisit_WhenErrorCodeIsRetryable() {
+	var sleeps []time.Duration
+	defer stubSleep(&sleeps)()
+	var attempts []model.HandlerAttempt
+	hooks := model.FsmHooks[testJourneyData]{OnHandlerAttempt: func(attempt model.HandlerAttempt) { attempts = append(attempts, attempt) }}
+	policy := &model.RetryPolicy{MaxAttempts: 3, InitialBackoff: 100 * time.Millisecond, RetryableErrorCodes: []string{"GATEWAY_TIMEOUT"}}
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Pay", DestinationStateName: "Payment"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "Payment",
+			NextScreen:   "PaymentScreen",
+			StateHandler: suite.mockStateHandler,
+			RetryPolicy:  policy,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, hooks)
+	suite.Nil(err)
+
+	timeout := nuErrors.New("GATEWAY_TIMEOUT", 504)
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Payment", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	gomock.InOrder(
+		suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, nil, "", timeout).Times(2),
+		suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return("paid", testJourneyData{}, "TransitionComplete", nil).Times(1),
+	)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Pay"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", Data: "paid", NextScreen: "PaymentScreen"}, response)
+	suite.Nil(err)
+	suite.Equal([]time.Duration{100 * time.Millisecond, 200 * time.Millisecond}, sleeps)
+	suite.Equal([]model.HandlerAttempt{
+		{JID: "some-uuid", StateName: "Payment", Method: "Visit", Attempt: 1, Err: timeout, WillRetry: true},
+		{JID: "some-uuid", StateName: "Payment", Method: "Visit", Attempt: 2, Err: timeout, WillRetry: true},
+	}, attempts)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldNotRetryVisit_WhenErrorCodeIsNotRetryable() {
+	var sleeps []time.Duration
+	defer stubSleep(&sleeps)()
+	policy := &model.RetryPolicy{MaxAttempts: 3, InitialBackoff: 100 * time.Millisecond, RetryableErrorCodes: []string{"GATEWAY_TIMEOUT"}}
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Pay", DestinationStateName: "Payment"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "Payment",
+			NextScreen:   "PaymentScreen",
+			StateHandler: suite.mockStateHandler,
+			RetryPolicy:  policy,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	declined := nuErrors.New("PAYMENT_DECLINED", 402)
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, nil, "", declined).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Pay"})
+
+	suite.Equal(model.FsmResponse{}, response)
+	suite.Equal(fsmErrors.HandlerFailedError("Payment", declined), err)
+	suite.Empty(sleeps)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldStopRetrying_WhenMaxAttemptsReached() {
+	var sleeps []time.Duration
+	defer stubSleep(&sleeps)()
+	policy := &model.RetryPolicy{MaxAttempts: 2, InitialBackoff: 100 * time.Millisecond}
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Pay", DestinationStateName: "Payment"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "Payment",
+			NextScreen:   "PaymentScreen",
+			StateHandler: suite.mockStateHandler,
+			RetryPolicy:  policy,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	timeout := nuErrors.New("GATEWAY_TIMEOUT", 504)
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, nil, "", timeout).Times(2)
+
+	_, err = service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Pay"})
+
+	suite.Equal(fsmErrors.HandlerFailedError("Payment", timeout), err)
+	suite.Equal([]time.Duration{100 * time.Millisecond}, sleeps)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldNotRetry_WhenBackoffExceedsDeadline() {
+	var sleeps []time.Duration
+	defer stubSleep(&sleeps)()
+	now := time.Now()
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+	var attempts []model.HandlerAttempt
+	hooks := model.FsmHooks[testJourneyData]{OnHandlerAttempt: func(attempt model.HandlerAttempt) { attempts = append(attempts, attempt) }}
+	policy := &model.RetryPolicy{MaxAttempts: 3, InitialBackoff: 2 * time.Minute}
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Pay", DestinationStateName: "Payment"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "Payment",
+			NextScreen:   "PaymentScreen",
+			StateHandler: suite.mockStateHandler,
+			RetryPolicy:  policy,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, hooks)
+	suite.Nil(err)
+
+	ctx, cancel := context.WithDeadline(suite.ctx, now.Add(time.Minute))
+	defer cancel()
+	timeout := nuErrors.New("GATEWAY_TIMEOUT", 504)
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(ctx, "some-uuid", testJourneyData{}, nil).Return(nil, nil, "", timeout).Times(1)
+
+	_, err = service.Execute(ctx, model.FsmRequest{JID: "some-uuid", Event: "Pay"})
+
+	suite.Equal(fsmErrors.HandlerFailedError("Payment", timeout), err)
+	suite.Empty(sleeps)
+	suite.Equal([]model.HandlerAttempt{{JID: "some-uuid", StateName: "Payment", Method: "Visit", Attempt: 1, Err: timeout, WillRetry: false}}, attempts)
+}
+
+func (suite *fsmServiceTestSuite) TestRetryBackoff_ShouldCapAtMaxBackoffAndApplyJitter() {
+	randFloat64 = func() float64 { return 0.5 }
+	defer func() { randFloat64 = rand.Float64 }()
+	policy := model.RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 3 * time.Second, Multiplier: 3, Jitter: 0.2}
+
+	suite.Equal(900*time.Millisecond, retryBackoff(policy, 1))
+	suite.Equal(2700*time.Millisecond, retryBackoff(policy, 2))
+	suite.Equal(2700*time.Millisecond, retryBackoff(policy, 3))
+}
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Novato-Now/novato-fsm/constants"
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/model"
+	nuErrors "github.com/Novato-Now/novato-utils/errors"
+	"github.com/Novato-Now/novato-utils/logging"
+)
+
+type transitionResolver func(ctx context.Context, currentState model.FsmState, event string) (model.NextAvailableEvent, model.FsmState, *nuErrors.Error)
+
+// Simulate predicts the outcome of a request without saving the journey or running hooks and state actions.
+// Only handlers of states flagged as IsPure are invoked; the prediction stops at the first impure state.
+func (fs fsmService[T]) Simulate(ctx context.Context, request model.FsmRequest) (model.SimulationResult, *nuErrors.Error) {
+	log := logging.GetLogger(ctx)
+
+	if request.JID == "" {
+		if request.Event != constants.EventNameStart {
+			log.Error("Invalid event name for new journey.")
+			return model.SimulationResult{}, fsmErrors.InvalidStartEventError(request.Event)
+		}
+		initState, err := fs.getState(ctx, fs.initialStateName)
+		if err != nil {
+			return model.SimulationResult{}, err
+		}
+		_, err = validateRequestData(ctx, "", initState.RequestValidator, request.Data)
+		if err != nil {
+			return model.SimulationResult{}, err
+		}
+		return fs.simulateVisits(ctx, model.Journey[T]{}, initState, request.Data, fs.getNextTransition)
+	}
+
+	journey, err := fs.journeyStore.Get(ctx, request.JID)
+	if err != nil {
+		log.Errorf("Error from journey store. Error %+v", err)
+		return model.SimulationResult{}, err
+	}
+	fs, err = fs.forJourney(ctx, journey)
+	if err != nil {
+		return model.SimulationResult{}, err
+	}
+	if journey.IsCompleted() && !slices.Contains(fs.eventsAfterCompletion, request.Event) {
+		log.Errorf("Event %s is not allowed for completed journey", request.Event)
+		return model.SimulationResult{}, fsmErrors.ConflictError(fmt.Sprintf("journey %s is already completed", journey.JID))
+	}
+	err = fs.checkPendingEvent(ctx, journey, request.Event, executeOptions{})
+	if err != nil {
+		return model.SimulationResult{}, err
+	}
+
+	nextTransition := fs.getNextTransition
+	currentStage := journey.CurrentStage
+	if request.Region != "" {
+		region, ok := journey.Regions[request.Region]
+		if !ok || region.IsComplete {
+			log.Errorf("Region %s is not active for journey %s", request.Region, journey.JID)
+			return model.SimulationResult{}, fsmErrors.ConflictError(fmt.Sprintf("region %s is not active", request.Region))
+		}
+		nextTransition = fs.getNextRegionTransition
+		currentStage = region.CurrentStage
+	} else if request.Event == constants.EventNameResume {
+		state, err := fs.getState(ctx, journey.LastCheckpointStage)
+		if err != nil {
+			return model.SimulationResult{}, err
+		}
+		return fs.simulateRevisit(ctx, journey, state)
+	}
+
+	currentState, err := fs.getState(ctx, currentStage)
+	if err != nil {
+		return model.SimulationResult{}, err
+	}
+	transition, nextState, err := nextTransition(ctx, currentState, request.Event)
+	if err != nil {
+		return model.SimulationResult{}, err
+	}
+	if request.Event == constants.EventNameBack {
+		return fs.simulateRevisit(ctx, journey, nextState)
+	}
+	_, err = validateRequestData(ctx, journey.JID, requestValidatorFor(transition, nextState), request.Data)
+	if err != nil {
+		return model.SimulationResult{}, err
+	}
+	return fs.simulateVisits(ctx, journey, nextState, request.Data, nextTransition)
+}
+
+func (fs fsmService[T]) simulateVisits(ctx context.Context, journey model.Journey[T], state model.FsmState, data any, nextTransition transitionResolver) (model.SimulationResult, *nuErrors.Error) {
+	log := logging.GetLogger(ctx)
+	var result model.SimulationResult
+	var routedStates []string
+	for {
+		if state.IsJoin && !allRegionsComplete(journey.Regions) {
+			log.Errorf("Cannot enter join state %s before all parallel regions are complete", state.Name)
+			return model.SimulationResult{}, fsmErrors.ConflictError(fmt.Sprintf("parallel regions of journey %s are not complete", journey.JID))
+		}
+		result.Path = append(result.Path, state.Name)
+		result.NextScreen = state.NextScreen
+		result.MetaData = state.MetaData
+		result.Data = nil
+		if !state.IsPure {
+			log.Infof("Stopping simulation at impure state %s", state.Name)
+			result.IsPartial = true
+			return result, nil
+		}
+
+		resp, updatedJourneyData, nextEvent, err := state.StateHandler.Visit(ctx, journey.JID, journey.Data, data)
+		if err != nil {
+			log.Errorf("State handler visit method failed with error: %+v", err)
+			errorState, ok := fs.getErrorTransition(ctx, state, err, routedStates)
+			if !ok {
+				return model.SimulationResult{}, handlerFailed(state.Name, err)
+			}
+			routedStates = append(routedStates, state.Name)
+			state, data = errorState, err
+			continue
+		}
+		routedStates = nil
+		journey.Data, err = handlerJourneyData[T](ctx, state.Name, updatedJourneyData)
+		if err != nil {
+			return model.SimulationResult{}, err
+		}
+		journey.Regions = regionsOnEnter(state, journey.Regions, false)
+		result.Data = resp
+		if state.IsAsync && nextEvent == constants.EventNamePending {
+			log.Infof("Stopping simulation at state %s waiting for an external event", state.Name)
+			result.IsPending = true
+			return result, nil
+		}
+		if nextEvent == constants.EventNameTransitionComplete {
+			return result, nil
+		}
+
+		_, state, err = nextTransition(ctx, state, nextEvent)
+		if err != nil {
+			return model.SimulationResult{}, err
+		}
+		data = resp
+	}
+}
+
+func (fs fsmService[T]) simulateRevisit(ctx context.Context, journey model.Journey[T], state model.FsmState) (model.SimulationResult, *nuErrors.Error) {
+	log := logging.GetLogger(ctx)
+	result := model.SimulationResult{
+		Path:       []string{state.Name},
+		NextScreen: state.NextScreen,
+		MetaData:   state.MetaData,
+	}
+	if !state.IsPure {
+		log.Infof("Stopping simulation at impure state %s", state.Name)
+		result.IsPartial = true
+		return result, nil
+	}
+	resp, _, err := state.StateHandler.Revisit(ctx, journey.JID, journey.Data)
+	if err != nil {
+		log.Errorf("State handler revisit method failed with error: %+v", err)
+		return model.SimulationResult{}, handlerFailed(state.Name, err)
+	}
+	result.Data = resp
+	return result, nil
+}
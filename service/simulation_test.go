@@ -0,0 +1,298 @@
+package service
+
+import (
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/model"
+	nuErrors "github.com/Novato-Now/novato-utils/errors"
+)
+
+func (suite *fsmServiceTestSuite) TestSimulate_ShouldPredictPathWithoutSideEffects_WhenStatesArePure() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		IsPure:              true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "StateA",
+			NextScreen:   "ScreenA",
+			StateHandler: suite.mockStateHandler,
+			IsPure:       true,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "Back", DestinationStateName: "Init"},
+				{Event: "Next", DestinationStateName: "StateB"},
+			},
+		},
+		{
+			Name:                "StateB",
+			NextScreen:          "ScreenB",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateC"}},
+		},
+		{
+			Name:         "StateC",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, "input").Return("response-a", testJourneyData{}, "Next", nil).Times(1)
+
+	result, err := service.Simulate(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next", Data: "input"})
+
+	suite.Equal(model.SimulationResult{Path: []string{"StateA", "StateB"}, NextScreen: "ScreenB", IsPartial: true}, result)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestSimulate_ShouldReturnHandlerResponse_WhenChainCompletesAtPureState() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		IsPure:              true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "StateA",
+			NextScreen:   "ScreenA",
+			StateHandler: suite.mockStateHandler,
+			IsPure:       true,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "Back", DestinationStateName: "Init"},
+				{Event: "Next", DestinationStateName: "StateB"},
+			},
+		},
+		{
+			Name:                "StateB",
+			NextScreen:          "ScreenB",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateC"}},
+		},
+		{
+			Name:         "StateC",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "", testJourneyData{}, nil).Return("response-init", testJourneyData{}, "TransitionComplete", nil).Times(1)
+
+	result, err := service.Simulate(suite.ctx, model.FsmRequest{Event: "Start"})
+
+	suite.Equal(model.SimulationResult{Path: []string{"Init"}, NextScreen: "InitScreen", Data: "response-init"}, result)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestSimulate_ShouldRevisitPreviousState_WhenEventIsBack() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		IsPure:              true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "StateA",
+			NextScreen:   "ScreenA",
+			StateHandler: suite.mockStateHandler,
+			IsPure:       true,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "Back", DestinationStateName: "Init"},
+				{Event: "Next", DestinationStateName: "StateB"},
+			},
+		},
+		{
+			Name:                "StateB",
+			NextScreen:          "ScreenB",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateC"}},
+		},
+		{
+			Name:         "StateC",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateA", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Revisit(suite.ctx, "some-uuid", testJourneyData{}).Return("response-init", testJourneyData{}, nil).Times(1)
+
+	result, err := service.Simulate(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Back"})
+
+	suite.Equal(model.SimulationResult{Path: []string{"Init"}, NextScreen: "InitScreen", Data: "response-init"}, result)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestSimulate_ShouldReturnInvalidEventError_WhenEventIsInvalid() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		IsPure:              true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateA"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "StateA",
+			NextScreen:   "ScreenA",
+			StateHandler: suite.mockStateHandler,
+			IsPure:       true,
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "Back", DestinationStateName: "Init"},
+				{Event: "Next", DestinationStateName: "StateB"},
+			},
+		},
+		{
+			Name:                "StateB",
+			NextScreen:          "ScreenB",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "StateC"}},
+		},
+		{
+			Name:         "StateC",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "StateB", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+
+	result, err := service.Simulate(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Unknown"})
+
+	suite.Equal(model.SimulationResult{}, result)
+	suite.Equal(fsmErrors.InvalidEventError("StateB", "Unknown"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestSimulate_ShouldReturnConflict_WhenJourneyIsPending() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		IsPure:              true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "VerifyAccount", DestinationStateName: "AccountVerification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:                "AccountVerification",
+			NextScreen:          "VerificationInProgressScreen",
+			StateHandler:        suite.mockStateHandler,
+			IsAsync:             true,
+			IsPure:              true,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "AccountVerified", DestinationStateName: "Verified"}},
+		},
+		{
+			Name:         "Verified",
+			NextScreen:   "VerifiedScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "AccountVerification", LastCheckpointStage: "Init", IsPending: true}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+
+	result, err := service.Simulate(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "AccountVerified"})
+
+	suite.Equal(model.SimulationResult{}, result)
+	suite.Equal(fsmErrors.ConflictError("journey some-uuid is waiting for an external event"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestSimulate_ShouldStopAtAsyncState_WhenHandlerReturnsPending() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		IsPure:              true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "VerifyAccount", DestinationStateName: "AccountVerification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:                "AccountVerification",
+			NextScreen:          "VerificationInProgressScreen",
+			StateHandler:        suite.mockStateHandler,
+			IsAsync:             true,
+			IsPure:              true,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "AccountVerified", DestinationStateName: "Verified"}},
+		},
+		{
+			Name:         "Verified",
+			NextScreen:   "VerifiedScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return("verifying", testJourneyData{}, "Pending", nil).Times(1)
+
+	result, err := service.Simulate(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "VerifyAccount"})
+
+	suite.Equal(model.SimulationResult{Path: []string{"AccountVerification"}, NextScreen: "VerificationInProgressScreen", Data: "verifying", IsPending: true}, result)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestSimulate_ShouldFollowErrorTransition_WhenHandlerFails() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		IsPure:              true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Pay", DestinationStateName: "Payment"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:             "Payment",
+			NextScreen:       "PaymentScreen",
+			StateHandler:     suite.mockStateHandler,
+			IsPure:           true,
+			ErrorTransitions: []model.ErrorTransition{{ErrorCode: "PAYMENT_DECLINED", DestinationStateName: "PaymentFailed"}},
+		},
+		{
+			Name:         "PaymentFailed",
+			NextScreen:   "PaymentFailedScreen",
+			StateHandler: suite.mockStateHandler,
+			IsPure:       true,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	declined := nuErrors.New("PAYMENT_DECLINED", 402)
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, "card").Return(nil, nil, "", declined).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, declined).Return("retry-payment", testJourneyData{}, "TransitionComplete", nil).Times(1)
+
+	result, err := service.Simulate(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Pay", Data: "card"})
+
+	suite.Equal(model.SimulationResult{Path: []string{"Payment", "PaymentFailed"}, NextScreen: "PaymentFailedScreen", Data: "retry-payment"}, result)
+	suite.Nil(err)
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/model"
+	nuErrors "github.com/Novato-Now/novato-utils/errors"
+	"github.com/Novato-Now/novato-utils/logging"
+)
+
+// isStateChange reports whether moving from previousStateName to state leaves one state and enters another. An event
+// that leads back to the current state leaves and re-enters it, while revisiting the current state, as Resume does,
+// stays in it, so its exit and entry actions do not run.
+func isStateChange(previousStateName string, state model.FsmState, isRevisit bool) bool {
+	return previousStateName != state.Name || !isRevisit
+}
+
+// runExitAction runs the exit action of the state a journey leaves before the handler of state is called.
+func (fs fsmService[T]) runExitAction(ctx context.Context, jID string, previousStateName string, state model.FsmState, journeyData T, isRevisit bool) (T, *nuErrors.Error) {
+	if previousStateName == "" || !isStateChange(previousStateName, state, isRevisit) {
+		return journeyData, nil
+	}
+	log := logging.GetLogger(ctx)
+	previousState, err := fs.getState(ctx, previousStateName)
+	if err != nil {
+		return journeyData, err
+	}
+	if previousState.OnExit == nil {
+		return journeyData, nil
+	}
+	log.Infof("Running exit action of state %s", previousState.Name)
+	journeyData, err = runStateAction[T](ctx, previousState.Name, previousState.OnExit, jID, journeyData)
+	if err != nil {
+		log.Errorf("Exit action of state %s failed with error: %+v", previousState.Name, err)
+	}
+	return journeyData, err
+}
+
+// runEntryAction runs the entry action of state once its handler has succeeded, so a state whose handler fails is
+// never entered.
+func (fs fsmService[T]) runEntryAction(ctx context.Context, jID string, previousStateName string, state model.FsmState, journeyData T, isRevisit bool) (T, *nuErrors.Error) {
+	if state.OnEnter == nil || !isStateChange(previousStateName, state, isRevisit) {
+		return journeyData, nil
+	}
+	log := logging.GetLogger(ctx)
+	log.Infof("Running entry action of state %s", state.Name)
+	updatedJourneyData, err := runStateAction[T](ctx, state.Name, state.OnEnter, jID, journeyData)
+	if err != nil {
+		log.Errorf("Entry action of state %s failed with error: %+v", state.Name, err)
+		return journeyData, err
+	}
+	return updatedJourneyData, nil
+}
+
+func runStateAction[T any](ctx context.Context, stateName string, action model.StateAction, jID string, journeyData T) (T, *nuErrors.Error) {
+	updatedJourneyData, err := action(ctx, jID, journeyData)
+	if err != nil {
+		return journeyData, err
+	}
+	typedJourneyData, ok := updatedJourneyData.(T)
+	if !ok {
+		log := logging.GetLogger(ctx)
+		log.Errorf("Action of state %s returned journey data of type %T", stateName, updatedJourneyData)
+		return journeyData, fsmErrors.JourneyDataTypeError(fmt.Sprintf("%T", typedJourneyData), fmt.Sprintf("%T", updatedJourneyData))
+	}
+	return typedJourneyData, nil
+}
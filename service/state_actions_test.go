@@ -0,0 +1,344 @@
+package service
+
+import (
+	"context"
+
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/model"
+	nuErrors "github.com/Novato-Now/novato-utils/errors"
+)
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldRunExitAndEntryActions_WhenUserTransitionsToNextState() {
+	var actions []string
+	initState := model.FsmState{
+		Name:         "Init",
+		NextScreen:   "InitScreen",
+		StateHandler: suite.mockStateHandler,
+		IsCheckpoint: true,
+		OnExit: model.TypedStateAction(func(ctx context.Context, jID string, journeyData testJourneyData) (testJourneyData, *nuErrors.Error) {
+			actions = append(actions, "exit Init")
+			return journeyData, nil
+		}),
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "RequestOtp"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "RequestOtp",
+			NextScreen:   "OtpScreen",
+			StateHandler: suite.mockStateHandler,
+			OnEnter: model.TypedStateAction(func(ctx context.Context, jID string, journeyData testJourneyData) (testJourneyData, *nuErrors.Error) {
+				actions = append(actions, "enter RequestOtp")
+				journeyData.StateACompleted = true
+				return journeyData, nil
+			}),
+			OnExit: model.TypedStateAction(func(ctx context.Context, jID string, journeyData testJourneyData) (testJourneyData, *nuErrors.Error) {
+				actions = append(actions, "exit RequestOtp")
+				return journeyData, nil
+			}),
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Back", DestinationStateName: "Init"}},
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journeyData := testJourneyData{InitStateCompleted: true}
+	enteredJourneyData := testJourneyData{InitStateCompleted: true, StateACompleted: true}
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init", Data: journeyData}
+	expectedJourney := model.Journey[testJourneyData]{
+		JID:                 "some-uuid",
+		CurrentStage:        "RequestOtp",
+		LastCheckpointStage: "Init",
+		Data:                enteredJourneyData,
+	}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", journeyData, nil).
+		DoAndReturn(func(context.Context, string, any, any) (any, any, string, *nuErrors.Error) {
+			actions = append(actions, "visit RequestOtp")
+			return nil, journeyData, "TransitionComplete", nil
+		}).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", NextScreen: "OtpScreen"}, response)
+	suite.Nil(err)
+	suite.Equal([]string{"exit Init", "visit RequestOtp", "enter RequestOtp"}, actions)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldRunExitAction_WhenUserGoesBack() {
+	var actions []string
+	initState := model.FsmState{
+		Name:         "Init",
+		NextScreen:   "InitScreen",
+		StateHandler: suite.mockStateHandler,
+		IsCheckpoint: true,
+		OnExit: model.TypedStateAction(func(ctx context.Context, jID string, journeyData testJourneyData) (testJourneyData, *nuErrors.Error) {
+			actions = append(actions, "exit Init")
+			return journeyData, nil
+		}),
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "RequestOtp"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "RequestOtp",
+			NextScreen:   "OtpScreen",
+			StateHandler: suite.mockStateHandler,
+			OnEnter: model.TypedStateAction(func(ctx context.Context, jID string, journeyData testJourneyData) (testJourneyData, *nuErrors.Error) {
+				actions = append(actions, "enter RequestOtp")
+				journeyData.StateACompleted = true
+				return journeyData, nil
+			}),
+			OnExit: model.TypedStateAction(func(ctx context.Context, jID string, journeyData testJourneyData) (testJourneyData, *nuErrors.Error) {
+				actions = append(actions, "exit RequestOtp")
+				return journeyData, nil
+			}),
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Back", DestinationStateName: "Init"}},
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "RequestOtp", LastCheckpointStage: "Init"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Revisit(suite.ctx, "some-uuid", testJourneyData{}).Return(nil, testJourneyData{}, nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Back"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", NextScreen: "InitScreen"}, response)
+	suite.Nil(err)
+	suite.Equal([]string{"exit RequestOtp"}, actions)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnError_WhenEntryActionFails() {
+	expectedError := nuErrors.InternalSystemError(suite.ctx).WithMessage("some-error")
+	var actions []string
+	initState := model.FsmState{
+		Name:         "Init",
+		NextScreen:   "InitScreen",
+		StateHandler: suite.mockStateHandler,
+		IsCheckpoint: true,
+		OnExit: model.TypedStateAction(func(ctx context.Context, jID string, journeyData testJourneyData) (testJourneyData, *nuErrors.Error) {
+			actions = append(actions, "exit Init")
+			return journeyData, nil
+		}),
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "RequestOtp"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "RequestOtp",
+			NextScreen:   "OtpScreen",
+			StateHandler: suite.mockStateHandler,
+			OnEnter: model.TypedStateAction(func(ctx context.Context, jID string, journeyData testJourneyData) (testJourneyData, *nuErrors.Error) {
+				actions = append(actions, "enter RequestOtp")
+				journeyData.StateACompleted = true
+				return journeyData, expectedError
+			}),
+			OnExit: model.TypedStateAction(func(ctx context.Context, jID string, journeyData testJourneyData) (testJourneyData, *nuErrors.Error) {
+				actions = append(actions, "exit RequestOtp")
+				return journeyData, nil
+			}),
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Back", DestinationStateName: "Init"}},
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next"})
+
+	suite.Empty(response)
+	suite.Equal(expectedError, err)
+	suite.Equal([]string{"exit Init", "enter RequestOtp"}, actions)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnJourneyDataTypeError_WhenEntryActionReturnsNilJourneyData() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "RequestOtp"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "RequestOtp",
+			NextScreen:   "OtpScreen",
+			StateHandler: suite.mockStateHandler,
+			OnEnter: func(ctx context.Context, jID string, journeyData any) (any, *nuErrors.Error) {
+				return nil, nil
+			},
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next"})
+
+	suite.Empty(response)
+	suite.Equal(fsmErrors.JourneyDataTypeError("service.testJourneyData", "<nil>"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnJourneyDataTypeError_WhenTypedActionReceivesOtherType() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "RequestOtp"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "RequestOtp",
+			NextScreen:   "OtpScreen",
+			StateHandler: suite.mockStateHandler,
+			OnEnter: model.TypedStateAction(func(ctx context.Context, jID string, journeyData string) (string, *nuErrors.Error) {
+				return journeyData, nil
+			}),
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next"})
+
+	suite.Empty(response)
+	suite.Equal(fsmErrors.JourneyDataTypeError("string", "service.testJourneyData"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldNotRunEntryAction_WhenHandlerFails() {
+	expectedError := nuErrors.InternalSystemError(suite.ctx).WithMessage("some-error")
+	var actions []string
+	initState := model.FsmState{
+		Name:         "Init",
+		NextScreen:   "InitScreen",
+		StateHandler: suite.mockStateHandler,
+		IsCheckpoint: true,
+		OnExit: model.TypedStateAction(func(ctx context.Context, jID string, journeyData testJourneyData) (testJourneyData, *nuErrors.Error) {
+			actions = append(actions, "exit Init")
+			return journeyData, nil
+		}),
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "RequestOtp"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "RequestOtp",
+			NextScreen:   "OtpScreen",
+			StateHandler: suite.mockStateHandler,
+			OnEnter: model.TypedStateAction(func(ctx context.Context, jID string, journeyData testJourneyData) (testJourneyData, *nuErrors.Error) {
+				actions = append(actions, "enter RequestOtp")
+				return journeyData, nil
+			}),
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, nil, "", expectedError).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next"})
+
+	suite.Empty(response)
+	suite.Equal(fsmErrors.HandlerFailedError("RequestOtp", expectedError), err)
+	suite.Equal([]string{"exit Init"}, actions)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldRunExitAndEntryActions_WhenEventLeadsBackToSameState() {
+	var actions []string
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "RequestOtp"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "RequestOtp",
+			NextScreen:   "OtpScreen",
+			StateHandler: suite.mockStateHandler,
+			OnEnter: model.TypedStateAction(func(ctx context.Context, jID string, journeyData testJourneyData) (testJourneyData, *nuErrors.Error) {
+				actions = append(actions, "enter RequestOtp")
+				return journeyData, nil
+			}),
+			OnExit: model.TypedStateAction(func(ctx context.Context, jID string, journeyData testJourneyData) (testJourneyData, *nuErrors.Error) {
+				actions = append(actions, "exit RequestOtp")
+				return journeyData, nil
+			}),
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "ResendOtp", DestinationStateName: "RequestOtp"}},
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "RequestOtp", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, journey).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "ResendOtp"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", NextScreen: "OtpScreen"}, response)
+	suite.Nil(err)
+	suite.Equal([]string{"exit RequestOtp", "enter RequestOtp"}, actions)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldNotRunActions_WhenUserResumes() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "RequestOtp"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "RequestOtp",
+			NextScreen:   "OtpScreen",
+			StateHandler: suite.mockStateHandler,
+			IsCheckpoint: true,
+			OnEnter: model.TypedStateAction(func(ctx context.Context, jID string, journeyData testJourneyData) (testJourneyData, *nuErrors.Error) {
+				suite.Fail("entry action ran on resume")
+				return journeyData, nil
+			}),
+			OnExit: model.TypedStateAction(func(ctx context.Context, jID string, journeyData testJourneyData) (testJourneyData, *nuErrors.Error) {
+				suite.Fail("exit action ran on resume")
+				return journeyData, nil
+			}),
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "RequestOtp", LastCheckpointStage: "RequestOtp"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Revisit(suite.ctx, "some-uuid", testJourneyData{}).Return(nil, testJourneyData{}, nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, journey).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Resume"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", NextScreen: "OtpScreen"}, response)
+	suite.Nil(err)
+}
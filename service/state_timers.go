@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Novato-Now/novato-fsm/model"
+	"github.com/Novato-Now/novato-utils/logging"
+)
+
+// rescheduleStateTimers replaces the timers of the state a journey left with those of the state it entered. A journey
+// that enters its current state again gets fresh timers as well.
+func (fs fsmService[T]) rescheduleStateTimers(ctx context.Context, previousJourney model.Journey[T], journey model.Journey[T], stateEntered bool) {
+	if fs.scheduler == nil || (previousJourney.CurrentStage == journey.CurrentStage && !stateEntered) {
+		return
+	}
+	log := logging.GetLogger(ctx)
+
+	if previousJourney.CurrentStage != "" {
+		err := fs.scheduler.Cancel(ctx, journey.JID)
+		if err != nil {
+			log.Warnf("Unable to cancel timers of state %s for JID %s", previousJourney.CurrentStage, journey.JID)
+		}
+	}
+
+	state, err := fs.getState(ctx, journey.CurrentStage)
+	if err != nil {
+		return
+	}
+	for _, timer := range state.Timers {
+		scheduledEvent := model.ScheduledEvent{
+			ID:        fmt.Sprintf("%s_%s_%s", journey.JID, state.Name, timer.Name),
+			JID:       journey.JID,
+			StateName: state.Name,
+			Event:     timer.Event,
+			DueAt:     timeNow().Add(timer.After),
+		}
+		err = fs.scheduler.Schedule(ctx, scheduledEvent)
+		if err != nil {
+			log.Errorf("Unable to schedule timer %s of state %s. Error: %+v", timer.Name, state.Name, err)
+		}
+	}
+}
+
+func (fs fsmService[T]) dispatchScheduledEvent(ctx context.Context, event model.ScheduledEvent) {
+	log := logging.GetLogger(ctx)
+	log.Infof("Dispatching scheduled event %s for jID %s", event.Event, event.JID)
+
+	journey, err := fs.journeyStore.Get(ctx, event.JID)
+	if err != nil {
+		log.Errorf("Error from journey store. Error: %+v", err)
+		return
+	}
+	if journey.CurrentStage != event.StateName {
+		log.Infof("Ignoring scheduled event %s as journey has left state %s", event.ID, event.StateName)
+		return
+	}
+
+	_, err = fs.execute(ctx, model.FsmRequest{JID: event.JID, Event: event.Event}, executeOptions{firesTimer: true})
+	if err != nil {
+		log.Errorf("Unable to execute scheduled event %s. Error: %+v", event.ID, err)
+	}
+}
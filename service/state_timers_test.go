@@ -0,0 +1,227 @@
+package service
+
+import (
+	"time"
+
+	"github.com/Novato-Now/novato-fsm/mocks"
+	"github.com/Novato-Now/novato-fsm/model"
+	"go.uber.org/mock/gomock"
+)
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldScheduleStateTimers_WhenUserEntersStateWithTimers() {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	mockScheduler := mocks.NewMockScheduler(suite.mockCtrl)
+	mockScheduler.EXPECT().SetDispatcher(gomock.Any()).Times(1)
+
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "UploadDocuments"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:                "UploadDocuments",
+			NextScreen:          "UploadDocumentsScreen",
+			StateHandler:        suite.mockStateHandler,
+			Timers:              []model.StateTimer{{Name: "reminder", After: 48 * time.Hour, Event: "Remind"}},
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Remind", DestinationStateName: "Reminder"}},
+		},
+		{
+			Name:         "Reminder",
+			NextScreen:   "ReminderScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{}, WithScheduler[testJourneyData](mockScheduler))
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "UploadDocuments", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+	mockScheduler.EXPECT().Cancel(suite.ctx, "some-uuid").Return(nil).Times(1)
+	mockScheduler.EXPECT().
+		Schedule(suite.ctx, model.ScheduledEvent{
+			ID:        "some-uuid_UploadDocuments_reminder",
+			JID:       "some-uuid",
+			StateName: "UploadDocuments",
+			Event:     "Remind",
+			DueAt:     now.Add(48 * time.Hour),
+		}).
+		Return(nil).
+		Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Next"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", NextScreen: "UploadDocumentsScreen"}, response)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestDispatchScheduledEvent_ShouldExecuteEvent_WhenJourneyIsStillInTimerState() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "UploadDocuments"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:                "UploadDocuments",
+			NextScreen:          "UploadDocumentsScreen",
+			StateHandler:        suite.mockStateHandler,
+			Timers:              []model.StateTimer{{Name: "reminder", After: 48 * time.Hour, Event: "Remind"}},
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Remind", DestinationStateName: "Reminder"}},
+		},
+		{
+			Name:         "Reminder",
+			NextScreen:   "ReminderScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service := fsmService[testJourneyData]{journeyStore: suite.mockJourneyStore, flow: flow{initialStateName: initState.Name, states: map[string]model.FsmState{initState.Name: initState}}}
+	for _, state := range nonInitStates {
+		service.states[state.Name] = state
+	}
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "UploadDocuments", LastCheckpointStage: "Init"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Reminder", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(2)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	service.dispatchScheduledEvent(suite.ctx, model.ScheduledEvent{JID: "some-uuid", StateName: "UploadDocuments", Event: "Remind"})
+}
+
+func (suite *fsmServiceTestSuite) TestDispatchScheduledEvent_ShouldIgnoreEvent_WhenJourneyHasLeftTimerState() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "UploadDocuments"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:                "UploadDocuments",
+			NextScreen:          "UploadDocumentsScreen",
+			StateHandler:        suite.mockStateHandler,
+			Timers:              []model.StateTimer{{Name: "reminder", After: 48 * time.Hour, Event: "Remind"}},
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Remind", DestinationStateName: "Reminder"}},
+		},
+		{
+			Name:         "Reminder",
+			NextScreen:   "ReminderScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service := fsmService[testJourneyData]{journeyStore: suite.mockJourneyStore, flow: flow{initialStateName: initState.Name, states: map[string]model.FsmState{initState.Name: initState}}}
+	for _, state := range nonInitStates {
+		service.states[state.Name] = state
+	}
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Reminder", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+
+	service.dispatchScheduledEvent(suite.ctx, model.ScheduledEvent{JID: "some-uuid", StateName: "UploadDocuments", Event: "Remind"})
+}
+
+func (suite *fsmServiceTestSuite) TestDispatchScheduledEvent_ShouldExecuteTimerEvent_WhenJourneyIsPending() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "VerifyAccount", DestinationStateName: "AccountVerification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:             "AccountVerification",
+			NextScreen:       "VerificationInProgressScreen",
+			StateHandler:     suite.mockStateHandler,
+			IsAsync:          true,
+			CompletionEvents: []string{"AccountVerified"},
+			Timers:           []model.StateTimer{{Name: "expiry", After: time.Hour, Event: "VerificationExpired"}},
+			NextAvailableEvents: []model.NextAvailableEvent{
+				{Event: "AccountVerified", DestinationStateName: "Verified"},
+				{Event: "VerificationExpired", DestinationStateName: "Init"},
+			},
+		},
+		{
+			Name:         "Verified",
+			NextScreen:   "VerifiedScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service := newFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "AccountVerification", LastCheckpointStage: "Init", IsPending: true}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(2)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	service.dispatchScheduledEvent(suite.ctx, model.ScheduledEvent{JID: "some-uuid", StateName: "AccountVerification", Event: "VerificationExpired"})
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldRescheduleStateTimers_WhenEventReentersTimerState() {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	mockScheduler := mocks.NewMockScheduler(suite.mockCtrl)
+	mockScheduler.EXPECT().SetDispatcher(gomock.Any()).Times(1)
+
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Next", DestinationStateName: "UploadDocuments"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:                "UploadDocuments",
+			NextScreen:          "UploadDocumentsScreen",
+			StateHandler:        suite.mockStateHandler,
+			Timers:              []model.StateTimer{{Name: "reminder", After: 48 * time.Hour, Event: "Remind"}},
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Remind", DestinationStateName: "UploadDocuments"}},
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{}, WithScheduler[testJourneyData](mockScheduler))
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "UploadDocuments", LastCheckpointStage: "Init"}
+
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, journey).Return(nil).Times(1)
+	gomock.InOrder(
+		mockScheduler.EXPECT().Cancel(suite.ctx, "some-uuid").Return(nil).Times(1),
+		mockScheduler.EXPECT().
+			Schedule(suite.ctx, model.ScheduledEvent{
+				ID:        "some-uuid_UploadDocuments_reminder",
+				JID:       "some-uuid",
+				StateName: "UploadDocuments",
+				Event:     "Remind",
+				DueAt:     now.Add(48 * time.Hour),
+			}).
+			Return(nil).
+			Times(1),
+	)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Remind"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", NextScreen: "UploadDocumentsScreen"}, response)
+	suite.Nil(err)
+}
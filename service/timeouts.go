@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/model"
+	nuErrors "github.com/Novato-Now/novato-utils/errors"
+	"github.com/Novato-Now/novato-utils/logging"
+)
+
+// handlerDeadline is the earlier of the state's own timeout and the deadline of the running Execute call.
+func handlerDeadline(state model.FsmState, executeDeadline time.Time) time.Time {
+	deadline := executeDeadline
+	if state.Timeout > 0 {
+		stateDeadline := timeNow().Add(state.Timeout)
+		if deadline.IsZero() || stateDeadline.Before(deadline) {
+			deadline = stateDeadline
+		}
+	}
+	return deadline
+}
+
+// newExecuteDeadline is the deadline of an Execute call starting now, or zero when no Execute timeout is configured.
+func (fs fsmService[T]) newExecuteDeadline() time.Time {
+	if fs.executeTimeout <= 0 {
+		return time.Time{}
+	}
+	return timeNow().Add(fs.executeTimeout)
+}
+
+// abandonedHandler remembers a handler call that callWithDeadline stopped waiting for. Go cannot stop the call, so
+// the next attempt of the same handler waits for it to return rather than running the handler twice at once.
+type abandonedHandler struct {
+	done <-chan struct{}
+}
+
+// wait blocks until the abandoned call returns and reports false if ctx is done first.
+func (a *abandonedHandler) wait(ctx context.Context) bool {
+	if a.done == nil {
+		return true
+	}
+	select {
+	case <-a.done:
+		a.done = nil
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// callWithDeadline runs handler with a context bounded by deadline and stops waiting for it once the deadline passes
+// or the caller's context is cancelled. The handler context is cancelled when callWithDeadline returns, and handlers
+// are expected to honour it. One that does not keeps running in the background and is recorded in abandoned, so that
+// a retry does not call the handler again until it has returned. A zero deadline runs handler on the caller's context.
+func callWithDeadline[R any](ctx context.Context, stateName string, deadline time.Time, abandoned *abandonedHandler, handler func(ctx context.Context) (R, *nuErrors.Error)) (R, *nuErrors.Error) {
+	var result R
+	if ctx.Err() != nil {
+		return result, contextError(ctx, stateName, ctx.Err())
+	}
+	if deadline.IsZero() {
+		return handler(ctx)
+	}
+	handlerCtx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+	if !abandoned.wait(handlerCtx) {
+		return result, contextError(ctx, stateName, handlerCtx.Err())
+	}
+
+	type outcome struct {
+		result R
+		err    *nuErrors.Error
+	}
+	done := make(chan outcome, 1)
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		result, err := handler(handlerCtx)
+		done <- outcome{result: result, err: err}
+	}()
+
+	select {
+	case o := <-done:
+		if o.err == nil || !errors.Is(handlerCtx.Err(), context.DeadlineExceeded) {
+			return o.result, o.err
+		}
+	case <-handlerCtx.Done():
+		abandoned.done = finished
+	}
+	return result, contextError(ctx, stateName, handlerCtx.Err())
+}
+
+// contextError reports a handler call that ended with its context: a passed deadline is a timeout, anything else a
+// cancelled request.
+func contextError(ctx context.Context, stateName string, err error) *nuErrors.Error {
+	log := logging.GetLogger(ctx)
+	if errors.Is(err, context.DeadlineExceeded) {
+		log.Errorf("Handler of state %s timed out", stateName)
+		return fsmErrors.TimeoutError(stateName)
+	}
+	log.Errorf("Request cancelled while waiting for handler of state %s", stateName)
+	return fsmErrors.CancelledError(stateName)
+}
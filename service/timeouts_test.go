@@ -0,0 +1,420 @@
+package service
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	fsmErrors "github.com/Novato-Now/novato-fsm/errors"
+	"github.com/Novato-Now/novato-fsm/model"
+	nuErrors "github.com/Novato-Now/novato-utils/errors"
+	"go.uber.org/mock/gomock"
+)
+
+func waitForDeadline(ctx context.Context, _ string, _ any, _ any) (any, any, string, *nuErrors.Error) {
+	<-ctx.Done()
+	return nil, nil, "", nuErrors.New("UPSTREAM_CANCELLED", 500)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnTimeoutError_WhenHandlerExceedsStateTimeout() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Verify", DestinationStateName: "Verification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:                "Verification",
+			NextScreen:          "VerificationScreen",
+			StateHandler:        suite.mockStateHandler,
+			Timeout:             10 * time.Millisecond,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Verified", DestinationStateName: "Done"}},
+		},
+		{
+			Name:         "VerificationPending",
+			NextScreen:   "VerificationPendingScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+		{
+			Name:         "Done",
+			NextScreen:   "DoneScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(gomock.Any(), "some-uuid", testJourneyData{}, nil).DoAndReturn(waitForDeadline).Times(1)
+
+	_, err = service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Verify"})
+
+	suite.Equal(fsmErrors.TimeoutError("Verification"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldStopWaiting_WhenHandlerIgnoresContext() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Verify", DestinationStateName: "Verification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:                "Verification",
+			NextScreen:          "VerificationScreen",
+			StateHandler:        suite.mockStateHandler,
+			Timeout:             10 * time.Millisecond,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Verified", DestinationStateName: "Done"}},
+		},
+		{
+			Name:         "VerificationPending",
+			NextScreen:   "VerificationPendingScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+		{
+			Name:         "Done",
+			NextScreen:   "DoneScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	release := make(chan struct{})
+	defer close(release)
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(gomock.Any(), "some-uuid", testJourneyData{}, nil).
+		DoAndReturn(func(context.Context, string, any, any) (any, any, string, *nuErrors.Error) {
+			<-release
+			return nil, testJourneyData{}, "TransitionComplete", nil
+		}).Times(1)
+
+	_, err = service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Verify"})
+
+	suite.Equal(fsmErrors.TimeoutError("Verification"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldRouteTimeoutToErrorState_WhenErrorTransitionIsDeclared() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Verify", DestinationStateName: "Verification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:                "Verification",
+			NextScreen:          "VerificationScreen",
+			StateHandler:        suite.mockStateHandler,
+			Timeout:             10 * time.Millisecond,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Verified", DestinationStateName: "Done"}},
+			ErrorTransitions:    []model.ErrorTransition{{ErrorCode: fsmErrors.CodeTimeout, DestinationStateName: "VerificationPending"}},
+		},
+		{
+			Name:         "VerificationPending",
+			NextScreen:   "VerificationPendingScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+		{
+			Name:         "Done",
+			NextScreen:   "DoneScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "VerificationPending", LastCheckpointStage: "Init"}
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(gomock.Any(), "some-uuid", testJourneyData{}, nil).DoAndReturn(waitForDeadline).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, fsmErrors.TimeoutError("Verification")).
+		Return("pending", testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Verify"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", Data: "pending", NextScreen: "VerificationPendingScreen"}, response)
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldBoundAutoTransitionChain_WhenExecuteTimeoutIsSet() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Verify", DestinationStateName: "Verification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:                "Verification",
+			NextScreen:          "VerificationScreen",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Verified", DestinationStateName: "Done"}},
+		},
+		{
+			Name:         "VerificationPending",
+			NextScreen:   "VerificationPendingScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+		{
+			Name:         "Done",
+			NextScreen:   "DoneScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{},
+		WithExecuteTimeout[testJourneyData](20*time.Millisecond))
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(gomock.Any(), "some-uuid", testJourneyData{}, nil).
+		DoAndReturn(func(ctx context.Context, _ string, _ any, _ any) (any, any, string, *nuErrors.Error) {
+			_, ok := ctx.Deadline()
+			suite.True(ok)
+			return "code-sent", testJourneyData{}, "Verified", nil
+		}).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(gomock.Any(), "some-uuid", testJourneyData{}, "code-sent").DoAndReturn(waitForDeadline).Times(1)
+
+	_, err = service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Verify"})
+
+	suite.Equal(fsmErrors.TimeoutError("Done"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldNotDeriveContext_WhenNoTimeoutIsSet() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Verify", DestinationStateName: "Verification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:                "Verification",
+			NextScreen:          "VerificationScreen",
+			StateHandler:        suite.mockStateHandler,
+			NextAvailableEvents: []model.NextAvailableEvent{{Event: "Verified", DestinationStateName: "Done"}},
+			ErrorTransitions:    []model.ErrorTransition{{ErrorCode: fsmErrors.CodeTimeout, DestinationStateName: "VerificationPending"}},
+		},
+		{
+			Name:         "VerificationPending",
+			NextScreen:   "VerificationPendingScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+		{
+			Name:         "Done",
+			NextScreen:   "DoneScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Verification", LastCheckpointStage: "Init"}
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(suite.ctx, "some-uuid", testJourneyData{}, nil).Return(nil, testJourneyData{}, "TransitionComplete", nil).Times(1)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	_, err = service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Verify"})
+
+	suite.Nil(err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnCancelledError_WhenRequestIsCancelledDuringHandler() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Verify", DestinationStateName: "Verification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "Verification",
+			NextScreen:   "VerificationScreen",
+			StateHandler: suite.mockStateHandler,
+			Timeout:      time.Minute,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	ctx, cancel := context.WithCancel(suite.ctx)
+	release := make(chan struct{})
+	defer close(release)
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	suite.mockJourneyStore.EXPECT().Get(ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(gomock.Any(), "some-uuid", testJourneyData{}, nil).
+		DoAndReturn(func(context.Context, string, any, any) (any, any, string, *nuErrors.Error) {
+			cancel()
+			<-release
+			return nil, testJourneyData{}, "TransitionComplete", nil
+		}).Times(1)
+
+	_, err = service.Execute(ctx, model.FsmRequest{JID: "some-uuid", Event: "Verify"})
+
+	suite.Equal(fsmErrors.CancelledError("Verification"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldCancelHandlerContext_WhenHandlerTimesOut() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Verify", DestinationStateName: "Verification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "Verification",
+			NextScreen:   "VerificationScreen",
+			StateHandler: suite.mockStateHandler,
+			Timeout:      10 * time.Millisecond,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	handlerCtx := make(chan context.Context, 1)
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(gomock.Any(), "some-uuid", testJourneyData{}, nil).
+		DoAndReturn(func(ctx context.Context, _ string, _ any, _ any) (any, any, string, *nuErrors.Error) {
+			handlerCtx <- ctx
+			return waitForDeadline(ctx, "", nil, nil)
+		}).Times(1)
+
+	_, err = service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Verify"})
+
+	suite.Equal(fsmErrors.TimeoutError("Verification"), err)
+	suite.Error((<-handlerCtx).Err())
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldNotRetryHandler_UntilTimedOutAttemptReturns() {
+	var sleeps []time.Duration
+	defer stubSleep(&sleeps)()
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Verify", DestinationStateName: "Verification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "Verification",
+			NextScreen:   "VerificationScreen",
+			StateHandler: suite.mockStateHandler,
+			Timeout:      50 * time.Millisecond,
+			RetryPolicy:  &model.RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond},
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	var running, maxRunning atomic.Int32
+	enter := func() {
+		if current := running.Add(1); current > maxRunning.Load() {
+			maxRunning.Store(current)
+		}
+	}
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	expectedJourney := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Verification", LastCheckpointStage: "Init"}
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	gomock.InOrder(
+		suite.mockStateHandler.EXPECT().Visit(gomock.Any(), "some-uuid", testJourneyData{}, nil).
+			DoAndReturn(func(context.Context, string, any, any) (any, any, string, *nuErrors.Error) {
+				enter()
+				defer running.Add(-1)
+				time.Sleep(80 * time.Millisecond)
+				return nil, testJourneyData{}, "TransitionComplete", nil
+			}).Times(1),
+		suite.mockStateHandler.EXPECT().Visit(gomock.Any(), "some-uuid", testJourneyData{}, nil).
+			DoAndReturn(func(context.Context, string, any, any) (any, any, string, *nuErrors.Error) {
+				enter()
+				defer running.Add(-1)
+				return "verified", testJourneyData{}, "TransitionComplete", nil
+			}).Times(1),
+	)
+	suite.mockJourneyStore.EXPECT().Save(suite.ctx, expectedJourney).Return(nil).Times(1)
+
+	response, err := service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Verify"})
+
+	suite.Equal(model.FsmResponse{JID: "some-uuid", Data: "verified", NextScreen: "VerificationScreen"}, response)
+	suite.Nil(err)
+	suite.Equal(int32(1), maxRunning.Load())
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldBoundErrorStateVisit_WhenExecuteTimeoutIsSet() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Verify", DestinationStateName: "Verification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:             "Verification",
+			NextScreen:       "VerificationScreen",
+			StateHandler:     suite.mockStateHandler,
+			ErrorTransitions: []model.ErrorTransition{{ErrorCode: fsmErrors.CodeTimeout, DestinationStateName: "VerificationPending"}},
+		},
+		{
+			Name:         "VerificationPending",
+			NextScreen:   "VerificationPendingScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{},
+		WithExecuteTimeout[testJourneyData](20*time.Millisecond))
+	suite.Nil(err)
+
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	suite.mockJourneyStore.EXPECT().Get(suite.ctx, "some-uuid").Return(journey, nil).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(gomock.Any(), "some-uuid", testJourneyData{}, nil).DoAndReturn(waitForDeadline).Times(1)
+	suite.mockStateHandler.EXPECT().Visit(gomock.Any(), "some-uuid", testJourneyData{}, fsmErrors.TimeoutError("Verification")).DoAndReturn(waitForDeadline).Times(1)
+
+	_, err = service.Execute(suite.ctx, model.FsmRequest{JID: "some-uuid", Event: "Verify"})
+
+	suite.Equal(fsmErrors.TimeoutError("VerificationPending"), err)
+}
+
+func (suite *fsmServiceTestSuite) TestExecute_ShouldReturnCancelledError_WhenRequestIsCancelledBeforeHandlerWithoutTimeout() {
+	initState := model.FsmState{
+		Name:                "Init",
+		NextScreen:          "InitScreen",
+		StateHandler:        suite.mockStateHandler,
+		IsCheckpoint:        true,
+		NextAvailableEvents: []model.NextAvailableEvent{{Event: "Verify", DestinationStateName: "Verification"}},
+	}
+	nonInitStates := []model.FsmState{
+		{
+			Name:         "Verification",
+			NextScreen:   "VerificationScreen",
+			StateHandler: suite.mockStateHandler,
+		},
+	}
+	service, err := NewFsmService(initState, nonInitStates, suite.mockJourneyStore, model.FsmHooks[testJourneyData]{})
+	suite.Nil(err)
+
+	ctx, cancel := context.WithCancel(suite.ctx)
+	cancel()
+	journey := model.Journey[testJourneyData]{JID: "some-uuid", CurrentStage: "Init", LastCheckpointStage: "Init"}
+	suite.mockJourneyStore.EXPECT().Get(ctx, "some-uuid").Return(journey, nil).Times(1)
+
+	_, err = service.Execute(ctx, model.FsmRequest{JID: "some-uuid", Event: "Verify"})
+
+	suite.Equal(fsmErrors.CancelledError("Verification"), err)
+}
@@ -8,6 +8,8 @@ import (
 
 //go:generate mockgen -destination=../mocks/mock_state_handler.go -package=mocks -source=state_handler.go
 
+// StateHandler runs the work of a state. Handlers must return once ctx is done: when a state or Execute timeout
+// expires the FSM cancels ctx and stops waiting for the result, and a retry of the call waits until it has returned.
 type StateHandler interface {
 	Visit(ctx context.Context, jID string, journeyData any, data any) (response any, updatedJourneyData any, nextEvent string, err *novato_errors.Error)
 	Revisit(ctx context.Context, jID string, journeyData any) (response any, updatedJourneyData any, err *novato_errors.Error)
@@ -0,0 +1,179 @@
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+)
+
+type jsonSchemaValidator struct {
+	schema   map[string]any
+	patterns map[string]*regexp.Regexp
+}
+
+// NewJSONSchemaValidator validates request data against a JSON Schema. The supported keywords are type,
+// properties, required, additionalProperties, items, enum, minLength, maxLength, pattern, minimum and maximum.
+func NewJSONSchemaValidator(schema []byte) (RequestValidator, error) {
+	var parsedSchema map[string]any
+	err := json.Unmarshal(schema, &parsedSchema)
+	if err != nil {
+		return nil, err
+	}
+	patterns := make(map[string]*regexp.Regexp)
+	err = compilePatterns(parsedSchema, patterns)
+	if err != nil {
+		return nil, err
+	}
+	return jsonSchemaValidator{schema: parsedSchema, patterns: patterns}, nil
+}
+
+func compilePatterns(schema map[string]any, patterns map[string]*regexp.Regexp) error {
+	if pattern, ok := schema["pattern"].(string); ok {
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("invalid pattern %s: %w", pattern, err)
+		}
+		patterns[pattern] = compiled
+	}
+	if itemSchema, ok := schema["items"].(map[string]any); ok {
+		if err := compilePatterns(itemSchema, patterns); err != nil {
+			return err
+		}
+	}
+	properties, _ := schema["properties"].(map[string]any)
+	for _, property := range properties {
+		if propertySchema, ok := property.(map[string]any); ok {
+			if err := compilePatterns(propertySchema, patterns); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (jv jsonSchemaValidator) Validate(data any) []FieldError {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return []FieldError{{Message: "payload is not valid JSON"}}
+	}
+	var document any
+	err = json.Unmarshal(payload, &document)
+	if err != nil {
+		return []FieldError{{Message: "payload is not valid JSON"}}
+	}
+	return jv.validateAgainstSchema(jv.schema, document, "")
+}
+
+func (jv jsonSchemaValidator) validateAgainstSchema(schema map[string]any, value any, path string) []FieldError {
+	if schemaType, ok := schema["type"].(string); ok && !matchesSchemaType(schemaType, value) {
+		return []FieldError{{Field: path, Message: fmt.Sprintf("must be of type %s", schemaType)}}
+	}
+
+	var fieldErrors []FieldError
+	if enum, ok := schema["enum"].([]any); ok && !slices.ContainsFunc(enum, func(option any) bool { return fmt.Sprint(option) == fmt.Sprint(value) }) {
+		fieldErrors = append(fieldErrors, FieldError{Field: path, Message: "must be one of the allowed values"})
+	}
+
+	switch typedValue := value.(type) {
+	case map[string]any:
+		fieldErrors = append(fieldErrors, jv.validateObject(schema, typedValue, path)...)
+	case []any:
+		if itemSchema, ok := schema["items"].(map[string]any); ok {
+			for i, item := range typedValue {
+				fieldErrors = append(fieldErrors, jv.validateAgainstSchema(itemSchema, item, path+"["+strconv.Itoa(i)+"]")...)
+			}
+		}
+	case string:
+		fieldErrors = append(fieldErrors, jv.validateString(schema, typedValue, path)...)
+	case float64:
+		fieldErrors = append(fieldErrors, validateNumber(schema, typedValue, path)...)
+	}
+	return fieldErrors
+}
+
+func (jv jsonSchemaValidator) validateObject(schema map[string]any, object map[string]any, path string) []FieldError {
+	var fieldErrors []FieldError
+	if required, ok := schema["required"].([]any); ok {
+		for _, name := range required {
+			if _, present := object[fmt.Sprint(name)]; !present {
+				fieldErrors = append(fieldErrors, FieldError{Field: joinFieldPath(path, fmt.Sprint(name)), Message: "is required"})
+			}
+		}
+	}
+
+	properties, _ := schema["properties"].(map[string]any)
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		propertySchema, ok := properties[name].(map[string]any)
+		if !ok {
+			if additionalProperties, ok := schema["additionalProperties"].(bool); ok && !additionalProperties {
+				fieldErrors = append(fieldErrors, FieldError{Field: joinFieldPath(path, name), Message: "is not allowed"})
+			}
+			continue
+		}
+		fieldErrors = append(fieldErrors, jv.validateAgainstSchema(propertySchema, object[name], joinFieldPath(path, name))...)
+	}
+	return fieldErrors
+}
+
+func (jv jsonSchemaValidator) validateString(schema map[string]any, value string, path string) []FieldError {
+	var fieldErrors []FieldError
+	length := float64(len([]rune(value)))
+	if minLength, ok := schema["minLength"].(float64); ok && length < minLength {
+		fieldErrors = append(fieldErrors, FieldError{Field: path, Message: fmt.Sprintf("must be at least %v characters long", minLength)})
+	}
+	if maxLength, ok := schema["maxLength"].(float64); ok && length > maxLength {
+		fieldErrors = append(fieldErrors, FieldError{Field: path, Message: fmt.Sprintf("must be at most %v characters long", maxLength)})
+	}
+	if pattern, ok := schema["pattern"].(string); ok {
+		if !jv.patterns[pattern].MatchString(value) {
+			fieldErrors = append(fieldErrors, FieldError{Field: path, Message: fmt.Sprintf("must match pattern %s", pattern)})
+		}
+	}
+	return fieldErrors
+}
+
+func validateNumber(schema map[string]any, value float64, path string) []FieldError {
+	var fieldErrors []FieldError
+	if minimum, ok := schema["minimum"].(float64); ok && value < minimum {
+		fieldErrors = append(fieldErrors, FieldError{Field: path, Message: fmt.Sprintf("must be at least %v", minimum)})
+	}
+	if maximum, ok := schema["maximum"].(float64); ok && value > maximum {
+		fieldErrors = append(fieldErrors, FieldError{Field: path, Message: fmt.Sprintf("must be at most %v", maximum)})
+	}
+	return fieldErrors
+}
+
+func matchesSchemaType(schemaType string, value any) bool {
+	switch schemaType {
+	case "object":
+		_, ok := value.(map[string]any)
+		return ok
+	case "array":
+		_, ok := value.([]any)
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		number, ok := value.(float64)
+		return ok && number == math.Trunc(number)
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "null":
+		return value == nil
+	}
+	return true
+}
@@ -0,0 +1,89 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const testSchema = `{
+	"type": "object",
+	"required": ["account_number", "documents"],
+	"additionalProperties": false,
+	"properties": {
+		"account_number": {"type": "string", "pattern": "^[0-9]+$", "minLength": 8},
+		"account_type": {"type": "string", "enum": ["SAVINGS", "CURRENT"]},
+		"amount": {"type": "number", "minimum": 1, "maximum": 1000},
+		"documents": {"type": "array", "items": {"type": "string"}}
+	}
+}`
+
+type jsonSchemaValidatorTestSuite struct {
+	suite.Suite
+	validator RequestValidator
+}
+
+func TestJSONSchemaValidatorTestSuite(t *testing.T) {
+	suite.Run(t, new(jsonSchemaValidatorTestSuite))
+}
+
+func (suite *jsonSchemaValidatorTestSuite) SetupTest() {
+	validator, err := NewJSONSchemaValidator([]byte(testSchema))
+	suite.Nil(err)
+	suite.validator = validator
+}
+
+func (suite *jsonSchemaValidatorTestSuite) TestNewJSONSchemaValidator_ShouldReturnError_WhenSchemaIsInvalid() {
+	validator, err := NewJSONSchemaValidator([]byte("{"))
+
+	suite.Nil(validator)
+	suite.NotNil(err)
+}
+
+func (suite *jsonSchemaValidatorTestSuite) TestNewJSONSchemaValidator_ShouldReturnError_WhenPatternIsInvalid() {
+	validator, err := NewJSONSchemaValidator([]byte(`{"type": "object", "properties": {"ifsc": {"type": "string", "pattern": "^[A-Z"}}}`))
+
+	suite.Nil(validator)
+	suite.ErrorContains(err, "invalid pattern ^[A-Z")
+}
+
+func (suite *jsonSchemaValidatorTestSuite) TestValidate_ShouldReturnNoErrors_WhenDataIsValid() {
+	fieldErrors := suite.validator.Validate(map[string]any{
+		"account_number": "12345678",
+		"account_type":   "SAVINGS",
+		"amount":         500,
+		"documents":      []string{"pan"},
+	})
+
+	suite.Empty(fieldErrors)
+}
+
+func (suite *jsonSchemaValidatorTestSuite) TestValidate_ShouldReturnFieldErrors_WhenDataViolatesSchema() {
+	fieldErrors := suite.validator.Validate(map[string]any{
+		"account_number": "12ab",
+		"account_type":   "LOAN",
+		"amount":         5000,
+		"ifsc":           "some-ifsc",
+	})
+
+	suite.Equal(
+		[]FieldError{
+			{Field: "documents", Message: "is required"},
+			{Field: "account_number", Message: "must be at least 8 characters long"},
+			{Field: "account_number", Message: "must match pattern ^[0-9]+$"},
+			{Field: "account_type", Message: "must be one of the allowed values"},
+			{Field: "amount", Message: "must be at most 1000"},
+			{Field: "ifsc", Message: "is not allowed"},
+		},
+		fieldErrors,
+	)
+}
+
+func (suite *jsonSchemaValidatorTestSuite) TestValidate_ShouldReturnFieldError_WhenArrayItemHasWrongType() {
+	fieldErrors := suite.validator.Validate(map[string]any{
+		"account_number": "12345678",
+		"documents":      []any{"pan", 1},
+	})
+
+	suite.Equal([]FieldError{{Field: "documents[1]", Message: "must be of type string"}}, fieldErrors)
+}
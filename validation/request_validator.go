@@ -0,0 +1,14 @@
+package validation
+
+type RequestValidator interface {
+	Validate(data any) []FieldError
+}
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+type ValidationErrors struct {
+	Errors []FieldError `json:"errors"`
+}
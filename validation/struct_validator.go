@@ -0,0 +1,135 @@
+package validation
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type structValidator[S any] struct{}
+
+// NewStructValidator validates request data by decoding it into S, rejecting unknown fields, and applying
+// `validate` tag rules (required, min=N, max=N) to the decoded fields.
+func NewStructValidator[S any]() RequestValidator {
+	return structValidator[S]{}
+}
+
+func (sv structValidator[S]) Validate(data any) []FieldError {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return []FieldError{{Message: "payload is not valid JSON"}}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.DisallowUnknownFields()
+	var target S
+	err = decoder.Decode(&target)
+	if err != nil {
+		return []FieldError{decodeErrorToFieldError(err)}
+	}
+
+	return validateStructFields(reflect.ValueOf(target), "")
+}
+
+func decodeErrorToFieldError(err error) FieldError {
+	var typeError *json.UnmarshalTypeError
+	if errors.As(err, &typeError) {
+		return FieldError{Field: typeError.Field, Message: fmt.Sprintf("must be of type %s", typeError.Type)}
+	}
+	if field, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+		return FieldError{Field: strings.Trim(field, `"`), Message: "is not allowed"}
+	}
+	return FieldError{Message: "payload does not match the expected structure"}
+}
+
+func validateStructFields(value reflect.Value, path string) []FieldError {
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var fieldErrors []FieldError
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		fieldPath := joinFieldPath(path, jsonFieldName(field))
+		fieldValue := value.Field(i)
+
+		for _, rule := range strings.Split(field.Tag.Get("validate"), ",") {
+			if rule == "" {
+				continue
+			}
+			if fieldError, ok := applyRule(rule, fieldValue, fieldPath); !ok {
+				fieldErrors = append(fieldErrors, fieldError)
+			}
+		}
+		fieldErrors = append(fieldErrors, validateStructFields(fieldValue, fieldPath)...)
+	}
+	return fieldErrors
+}
+
+func applyRule(rule string, value reflect.Value, fieldPath string) (FieldError, bool) {
+	name, argument, _ := strings.Cut(rule, "=")
+	switch name {
+	case "required":
+		if value.IsZero() {
+			return FieldError{Field: fieldPath, Message: "is required"}, false
+		}
+	case "min", "max":
+		limit, err := strconv.ParseFloat(argument, 64)
+		if err != nil {
+			return FieldError{Field: fieldPath, Message: fmt.Sprintf("has invalid rule %s", rule)}, false
+		}
+		size, ok := measure(value)
+		if !ok {
+			return FieldError{}, true
+		}
+		if name == "min" && size < limit {
+			return FieldError{Field: fieldPath, Message: fmt.Sprintf("must be at least %s", argument)}, false
+		}
+		if name == "max" && size > limit {
+			return FieldError{Field: fieldPath, Message: fmt.Sprintf("must be at most %s", argument)}, false
+		}
+	}
+	return FieldError{}, true
+}
+
+func measure(value reflect.Value) (float64, bool) {
+	switch value.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		return float64(value.Len()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(value.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return value.Float(), true
+	}
+	return 0, false
+}
+
+func jsonFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return field.Name
+	}
+	return name
+}
+
+func joinFieldPath(path string, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
@@ -0,0 +1,67 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type testNominee struct {
+	Name string `json:"name" validate:"required"`
+	Age  int    `json:"age" validate:"min=18,max=100"`
+}
+
+type testRequest struct {
+	AccountNumber string      `json:"account_number" validate:"required,min=8"`
+	Nominee       testNominee `json:"nominee"`
+}
+
+type structValidatorTestSuite struct {
+	suite.Suite
+	validator RequestValidator
+}
+
+func TestStructValidatorTestSuite(t *testing.T) {
+	suite.Run(t, new(structValidatorTestSuite))
+}
+
+func (suite *structValidatorTestSuite) SetupTest() {
+	suite.validator = NewStructValidator[testRequest]()
+}
+
+func (suite *structValidatorTestSuite) TestValidate_ShouldReturnNoErrors_WhenDataIsValid() {
+	fieldErrors := suite.validator.Validate(map[string]any{
+		"account_number": "12345678",
+		"nominee":        map[string]any{"name": "some-name", "age": 30},
+	})
+
+	suite.Empty(fieldErrors)
+}
+
+func (suite *structValidatorTestSuite) TestValidate_ShouldReturnFieldErrors_WhenRulesAreViolated() {
+	fieldErrors := suite.validator.Validate(map[string]any{
+		"account_number": "1234",
+		"nominee":        map[string]any{"age": 10},
+	})
+
+	suite.Equal(
+		[]FieldError{
+			{Field: "account_number", Message: "must be at least 8"},
+			{Field: "nominee.name", Message: "is required"},
+			{Field: "nominee.age", Message: "must be at least 18"},
+		},
+		fieldErrors,
+	)
+}
+
+func (suite *structValidatorTestSuite) TestValidate_ShouldReturnFieldError_WhenFieldHasWrongType() {
+	fieldErrors := suite.validator.Validate(map[string]any{"account_number": 12345678})
+
+	suite.Equal([]FieldError{{Field: "account_number", Message: "must be of type string"}}, fieldErrors)
+}
+
+func (suite *structValidatorTestSuite) TestValidate_ShouldReturnFieldError_WhenFieldIsUnknown() {
+	fieldErrors := suite.validator.Validate(map[string]any{"account_number": "12345678", "ifsc": "some-ifsc"})
+
+	suite.Equal([]FieldError{{Field: "ifsc", Message: "is not allowed"}}, fieldErrors)
+}